@@ -1,6 +1,6 @@
 module github.com/dinistavares/pipedrive-api
 
-go 1.17
+go 1.18
 
 require (
 	github.com/genert/pipedrive-api v0.0.0-20190827082315-8350139de8eb
@@ -74,7 +74,7 @@ type ActivitiesReponse struct {
 //
 // https://developers.pipedrive.com/docs/api/v1/#!/Activities/get_activities
 func (s *ActivitiesService) List(ctx context.Context) (*ActivitiesReponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/activities", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/activities", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -91,12 +91,33 @@ func (s *ActivitiesService) List(ctx context.Context) (*ActivitiesReponse, *Resp
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all activities.
+func (s *ActivitiesService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[Activity] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Activity, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/activities", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *ActivitiesReponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // GetByID returns details of a specific activity.
 //
 // https://developers.pipedrive.com/docs/api/v1/#!/Activities/get_activities
 func (s *ActivitiesService) GetByID(ctx context.Context, id int) (*ActivitiesReponse, *Response, error) {
   uri := fmt.Sprintf("/activities/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -117,7 +138,7 @@ func (s *ActivitiesService) GetByID(ctx context.Context, id int) (*ActivitiesRep
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/post_activities
 func (s *ActivitiesService) Create(ctx context.Context, opt *ActivitiesCreateOptions) (*ActivityResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/activities", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/activities", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -156,7 +177,7 @@ type ActivitiesCreateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/put_activities_id
 func (s *ActivitiesService) Update(ctx context.Context, id int, opt *ActivitiesCreateOptions) (*ActivityResponse, *Response, error) {
   uri := fmt.Sprintf("/activities/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -177,7 +198,7 @@ func (s *ActivitiesService) Update(ctx context.Context, id int, opt *ActivitiesC
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/delete_activities
 func (s *ActivitiesService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/activities", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/activities", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -192,7 +213,7 @@ func (s *ActivitiesService) DeleteMultiple(ctx context.Context, ids []int) (*Res
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/delete_activities_id
 func (s *ActivitiesService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/activities/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -29,10 +29,7 @@ type ActivityField struct {
   ImportantFlag      bool        `json:"important_flag"`
   BulkEditAllowed    bool        `json:"bulk_edit_allowed"`
   MandatoryFlag      bool        `json:"mandatory_flag"`
-  Options            []struct {
-    ID    string `json:"id"`
-    Label string `json:"label"`
-  } `json:"options,omitempty"`
+  Options            []FieldOption `json:"options,omitempty"`
 }
 
 // ActivityFieldsResponse represents multiple activity fields response.
@@ -46,7 +43,7 @@ type ActivityFieldsResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityFields/get_activityFields
 func (s *ActivityFieldsService) List(ctx context.Context) (*ActivityFieldsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/activityFields", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/activityFields", nil, nil)
 
   if err != nil {
     return nil, nil, err
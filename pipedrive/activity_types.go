@@ -47,7 +47,7 @@ type ActivityTypeResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/get_activityTypes
 func (s *ActivityTypesService) List(ctx context.Context) (*ActivityTypesResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/activityTypes", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/activityTypes", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -76,7 +76,7 @@ type ActivityTypesAddOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/post_activityTypes
 func (s *ActivityTypesService) Create(ctx context.Context, opt *ActivityTypesAddOptions) (*ActivityTypeResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/activityTypes", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/activityTypes", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -107,7 +107,7 @@ type ActivityTypesEditOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/put_activityTypes_id
 func (s *ActivityTypesService) Update(ctx context.Context, id int, opt *ActivityTypesEditOptions) (*ActivityTypeResponse, *Response, error) {
   uri := fmt.Sprintf("/activityTpes/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -128,7 +128,7 @@ func (s *ActivityTypesService) Update(ctx context.Context, id int, opt *Activity
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/delete_activityTypes
 func (s *ActivityTypesService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/activityTypes", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/activityTypes", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -144,7 +144,7 @@ func (s *ActivityTypesService) DeleteMultiple(ctx context.Context, ids []int) (*
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/delete_activityTypes_id
 func (s *ActivityTypesService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/activityTypes/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -95,7 +95,7 @@ type AuthorizationsListOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Authorizations/post_authorizations
 func (s *AuthorizationsService) List(ctx context.Context, opt *AuthorizationsListOptions) (*AuthorizationsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/authorizations", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/authorizations", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -0,0 +1,213 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "io"
+  "mime"
+  "net/http"
+  "path/filepath"
+  "strings"
+)
+
+// CallLogsService handles call logs related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/CallLogs
+type CallLogsService service
+
+// Content type of recordings whose type can not be told from the file name.
+const defaultRecordingContentType = "audio/mpeg"
+
+// CallOutcome is the outcome of a call.
+type CallOutcome string
+
+const (
+  CallOutcomeConnected     CallOutcome = "connected"
+  CallOutcomeNoAnswer      CallOutcome = "no_answer"
+  CallOutcomeLeftMessage   CallOutcome = "left_message"
+  CallOutcomeLeftVoicemail CallOutcome = "left_voicemail"
+  CallOutcomeWrongNumber   CallOutcome = "wrong_number"
+  CallOutcomeBusy          CallOutcome = "busy"
+)
+
+// CallLog represents a Pipedrive call log. Call logs are identified by
+// strings.
+type CallLog struct {
+  ID              string      `json:"id"`
+  UserID          int         `json:"user_id"`
+  CompanyID       int         `json:"company_id"`
+  ActivityID      *int        `json:"activity_id"`
+  PersonID        *int        `json:"person_id"`
+  OrgID           *int        `json:"org_id"`
+  DealID          *int        `json:"deal_id"`
+  LeadID          *string     `json:"lead_id"`
+  Subject         string      `json:"subject"`
+  Duration        string      `json:"duration"`
+  Outcome         CallOutcome `json:"outcome"`
+  FromPhoneNumber string      `json:"from_phone_number"`
+  ToPhoneNumber   string      `json:"to_phone_number"`
+  HasRecording    bool        `json:"has_recording"`
+  StartTime       string      `json:"start_time"`
+  EndTime         string      `json:"end_time"`
+  Note            string      `json:"note"`
+}
+
+func (c CallLog) String() string {
+  return Stringify(c)
+}
+
+// CallLogsResponse represents multiple call logs response.
+type CallLogsResponse struct {
+  Success        bool           `json:"success"`
+  Data           []CallLog      `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// CallLogResponse represents single call log response.
+type CallLogResponse struct {
+  Success bool    `json:"success"`
+  Data    CallLog `json:"data"`
+}
+
+// List call logs of the authorized user.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/CallLogs#getUserCallLogs
+func (s *CallLogsService) List(ctx context.Context, opt *ListOptions) (*CallLogsResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/callLogs", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *CallLogsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAll returns an iterator over all call logs of the authorized user.
+func (s *CallLogsService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[CallLog] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]CallLog, *AdditionalData, *Response, error) {
+    record, resp, err := s.List(ctx, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// Get a specific call log.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/CallLogs#getCallLog
+func (s *CallLogsService) Get(ctx context.Context, id string) (*CallLogResponse, *Response, error) {
+  uri := fmt.Sprintf("/callLogs/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *CallLogResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// CallLogCreateOptions specifices the parameters to the
+// CallLogsService.Create method. Outcome, ToPhoneNumber, StartTime and
+// EndTime are required.
+type CallLogCreateOptions struct {
+  Outcome         CallOutcome `json:"outcome"`
+  ToPhoneNumber   string      `json:"to_phone_number"`
+  FromPhoneNumber string      `json:"from_phone_number,omitempty"`
+
+  // Times in UTC, formatted as YYYY-MM-DD HH:MM:SS.
+  StartTime string `json:"start_time"`
+  EndTime   string `json:"end_time"`
+
+  // Duration of the call in seconds.
+  Duration string `json:"duration,omitempty"`
+
+  Subject    string `json:"subject,omitempty"`
+  Note       string `json:"note,omitempty"`
+  UserID     int    `json:"user_id,omitempty"`
+  ActivityID int    `json:"activity_id,omitempty"`
+  PersonID   int    `json:"person_id,omitempty"`
+  OrgID      int    `json:"org_id,omitempty"`
+  DealID     int    `json:"deal_id,omitempty"`
+  LeadID     string `json:"lead_id,omitempty"`
+}
+
+// Create a call log.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/CallLogs#addCallLog
+func (s *CallLogsService) Create(ctx context.Context, opt *CallLogCreateOptions) (*CallLogResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/callLogs", nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *CallLogResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Delete a call log. Its recording, if any, is deleted too.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/CallLogs#deleteCallLog
+func (s *CallLogsService) Delete(ctx context.Context, id string) (*Response, error) {
+  uri := fmt.Sprintf("/callLogs/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
+
+  if err != nil {
+    return nil, err
+  }
+
+  return s.client.Do(ctx, req, nil)
+}
+
+// UploadRecording attaches an audio recording to a call log. The content
+// type is detected from the file name and defaults to audio/mpeg.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/CallLogs#addCallLogAudioFile
+func (s *CallLogsService) UploadRecording(ctx context.Context, id string, fileName string, r io.Reader) (*Response, error) {
+  contentType := mime.TypeByExtension(filepath.Ext(fileName))
+
+  if !strings.HasPrefix(contentType, "audio/") {
+    contentType = defaultRecordingContentType
+  }
+
+  uri := fmt.Sprintf("/callLogs/%v/recordings", id)
+  req, err := s.client.NewMultipartRequestWithContext(ctx, http.MethodPost, uri, nil, nil, &MultipartFile{
+    FieldName:   "file",
+    FileName:    fileName,
+    ContentType: contentType,
+    Reader:      r,
+  })
+
+  if err != nil {
+    return nil, err
+  }
+
+  return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,39 @@
+package pipedrive
+
+import (
+  "context"
+  "io/ioutil"
+  "net/http"
+  "strings"
+  "testing"
+)
+
+func TestCallLogsService_UploadRecording(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/callLogs/abc/recordings", func(w http.ResponseWriter, r *http.Request) {
+    if err := r.ParseMultipartForm(1 << 20); err != nil {
+      t.Fatalf("ParseMultipartForm returned error: %v", err)
+    }
+
+    file, header, err := r.FormFile("file")
+
+    if err != nil {
+      t.Fatalf("FormFile returned error: %v", err)
+    }
+
+    data, _ := ioutil.ReadAll(file)
+
+    if header.Filename != "call.wav" || !strings.HasPrefix(header.Header.Get("Content-Type"), "audio/") || string(data) != "RIFF" {
+      t.Errorf("received %v %v %q", header.Filename, header.Header, data)
+    }
+
+    w.Write([]byte(`{"success":true}`))
+  })
+
+  _, err := client.CallLogs.UploadRecording(context.Background(), "abc", "call.wav", strings.NewReader("RIFF"))
+
+  if err != nil {
+    t.Fatalf("UploadRecording returned error: %v", err)
+  }
+}
@@ -1,5 +1,11 @@
 package pipedrive
 
+import (
+  "encoding/json"
+  "strconv"
+  "strings"
+)
+
 const (
   VisibleToOwnersAndFollowers = 1
   VisibleToWholeCompany       = 3
@@ -9,6 +15,7 @@ type Pagination struct {
   Start                 int  `json:"start"`
   Limit                 int  `json:"limit"`
   MoreItemsInCollection bool `json:"more_items_in_collection"`
+  NextStart             int  `json:"next_start,omitempty"`
 }
 
 type AdditionalData struct {
@@ -38,6 +45,10 @@ type AdditionalData struct {
   SinceTimestamp      string     `json:"since_timestamp"`
   LastTimestampOnPage string     `json:"last_timestamp_on_page"`
   Pagination          Pagination `json:"pagination"`
+
+  // Cursor of the next page of collections paginated by cursor. Empty on
+  // the last page.
+  NextCursor string `json:"next_cursor"`
 }
 
 type DeleteMultipleOptions struct {
@@ -104,8 +115,51 @@ const (
   FieldTypeTime        FieldType = "time"
   FieldTypeTimerange   FieldType = "timerange"
   FieldTypeDaterange   FieldType = "daterange"
+  FieldTypeAddress     FieldType = "address"
+  FieldTypeInt         FieldType = "int"
+  FieldTypeStage       FieldType = "stage"
+  FieldTypeStatus      FieldType = "status"
+  FieldTypeVisibleTo   FieldType = "visible_to"
 )
 
+// FieldOption represents an option of an enum or set field.
+type FieldOption struct {
+  ID    FieldOptionID `json:"id"`
+  Label string        `json:"label"`
+}
+
+// FieldOptionID is the ID of a field option. Options of custom fields have
+// numeric IDs, while some system fields use strings or booleans.
+type FieldOptionID string
+
+func (id *FieldOptionID) UnmarshalJSON(data []byte) error {
+  var s string
+
+  if err := json.Unmarshal(data, &s); err == nil {
+    *id = FieldOptionID(s)
+    return nil
+  }
+
+  if raw := strings.TrimSpace(string(data)); raw != "null" {
+    *id = FieldOptionID(raw)
+  }
+
+  return nil
+}
+
+func (id FieldOptionID) MarshalJSON() ([]byte, error) {
+  if _, err := strconv.ParseFloat(string(id), 64); err == nil || id == "true" || id == "false" {
+    return []byte(id), nil
+  }
+
+  return json.Marshal(string(id))
+}
+
+// Int returns a numeric option ID as int.
+func (id FieldOptionID) Int() (int, error) {
+  return strconv.Atoi(string(id))
+}
+
 // Visiblity
 type VisibleTo uint8
 
@@ -126,3 +180,37 @@ const (
 type SearchOptions struct {
   Term string `url:"term,omitempty"`
 }
+
+// Follower represents a user following a deal, person, organization or product.
+type Follower struct {
+  ID        int    `json:"id"`
+  UserID    int    `json:"user_id"`
+  DealID    int    `json:"deal_id,omitempty"`
+  PersonID  int    `json:"person_id,omitempty"`
+  OrgID     int    `json:"org_id,omitempty"`
+  ProductID int    `json:"product_id,omitempty"`
+  AddTime   string `json:"add_time"`
+}
+
+func (f Follower) String() string {
+  return Stringify(f)
+}
+
+// FollowersResponse represents multiple followers response.
+type FollowersResponse struct {
+  Success        bool           `json:"success"`
+  Data           []Follower     `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// FollowerResponse represents single follower response.
+type FollowerResponse struct {
+  Success bool     `json:"success"`
+  Data    Follower `json:"data"`
+}
+
+// PermittedUsersResponse represents the IDs of users allowed to access an item.
+type PermittedUsersResponse struct {
+  Success bool  `json:"success"`
+  Data    []int `json:"data"`
+}
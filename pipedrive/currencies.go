@@ -45,7 +45,7 @@ type CurrenciesListOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Currencies/get_currencies
 func (s *CurrenciesService) List(ctx context.Context, opt *CurrenciesListOptions) (*CurrenciesResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/currencies", opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/currencies", opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -0,0 +1,198 @@
+package pipedrive
+
+import (
+  "errors"
+  "fmt"
+  "reflect"
+  "strings"
+)
+
+// Struct tag naming the custom field a struct field maps to.
+const customFieldTag = "pipedrive"
+
+// taggedField is a struct field carrying a pipedrive tag.
+type taggedField struct {
+  index     int
+  name      string
+  omitEmpty bool
+}
+
+func taggedFields(t reflect.Type) []taggedField {
+  var fields []taggedField
+
+  for i := 0; i < t.NumField(); i++ {
+    tag, ok := t.Field(i).Tag.Lookup(customFieldTag)
+
+    if !ok || tag == "-" || t.Field(i).PkgPath != "" {
+      continue
+    }
+
+    parts := strings.Split(tag, ",")
+    field := taggedField{index: i, name: parts[0]}
+
+    for _, option := range parts[1:] {
+      if option == "omitempty" {
+        field.omitEmpty = true
+      }
+    }
+
+    fields = append(fields, field)
+  }
+
+  return fields
+}
+
+// structValue returns the struct v points to.
+func structValue(v interface{}) (reflect.Value, error) {
+  rv := reflect.ValueOf(v)
+
+  for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+    rv = rv.Elem()
+  }
+
+  if rv.Kind() != reflect.Struct {
+    return reflect.Value{}, fmt.Errorf("expected a struct or a pointer to a struct, got %T", v)
+  }
+
+  return rv, nil
+}
+
+// Marshal converts the fields of a struct tagged with the field name, e.g.
+//
+//   type Contract struct {
+//     Tier    string    `pipedrive:"Contract tier"`
+//     Renewal time.Time `pipedrive:"Renewal date,omitempty"`
+//   }
+//
+// into values keyed by field key, ready to be passed as CustomFields of
+// create and update options. Unknown field names, values not matching the
+// field type and unknown options are reported as errors.
+func (r *CustomFieldResolver) Marshal(v interface{}) (map[string]interface{}, error) {
+  rv, err := structValue(v)
+
+  if err != nil {
+    return nil, err
+  }
+
+  values := make(map[string]interface{})
+
+  for _, tagged := range taggedFields(rv.Type()) {
+    fv := rv.Field(tagged.index)
+
+    if tagged.omitEmpty && fv.IsZero() {
+      continue
+    }
+
+    if err := r.Set(values, tagged.name, plainValue(fv)); err != nil {
+      return nil, fmt.Errorf("%v.%v: %w", rv.Type().Name(), rv.Type().Field(tagged.index).Name, err)
+    }
+  }
+
+  return values, nil
+}
+
+// Unmarshal stores custom field values, e.g. the CustomFields of a Deal,
+// into the tagged fields of the struct v points to.
+func (r *CustomFieldResolver) Unmarshal(values map[string]interface{}, v interface{}) error {
+  rv := reflect.ValueOf(v)
+
+  if rv.Kind() != reflect.Ptr || rv.IsNil() {
+    return errors.New("Unmarshal expects a non-nil pointer to a struct")
+  }
+
+  rv, err := structValue(v)
+
+  if err != nil {
+    return err
+  }
+
+  for _, tagged := range taggedFields(rv.Type()) {
+    value, err := r.Get(values, tagged.name)
+
+    if err != nil {
+      return fmt.Errorf("%v.%v: %w", rv.Type().Name(), rv.Type().Field(tagged.index).Name, err)
+    }
+
+    if err := assignValue(rv.Field(tagged.index), value); err != nil {
+      return fmt.Errorf("%v.%v: %w", rv.Type().Name(), rv.Type().Field(tagged.index).Name, err)
+    }
+  }
+
+  return nil
+}
+
+// plainValue dereferences pointers and converts named string and slice
+// types to the types accepted by CustomFieldResolver.Set.
+func plainValue(v reflect.Value) interface{} {
+  for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+    if v.IsNil() {
+      return nil
+    }
+
+    v = v.Elem()
+  }
+
+  switch v.Kind() {
+  case reflect.String:
+    return v.String()
+  case reflect.Slice:
+    if v.Type().Elem().Kind() == reflect.String {
+      labels := make([]string, v.Len())
+
+      for i := range labels {
+        labels[i] = v.Index(i).String()
+      }
+
+      return labels
+    }
+  }
+
+  return v.Interface()
+}
+
+// assignValue stores a value returned by CustomFieldResolver.Get into dst.
+func assignValue(dst reflect.Value, value interface{}) error {
+  if value == nil {
+    dst.Set(reflect.Zero(dst.Type()))
+
+    return nil
+  }
+
+  if dst.Kind() == reflect.Ptr {
+    ptr := reflect.New(dst.Type().Elem())
+
+    if err := assignValue(ptr.Elem(), value); err != nil {
+      return err
+    }
+
+    dst.Set(ptr)
+
+    return nil
+  }
+
+  src := reflect.ValueOf(value)
+
+  switch {
+  case src.Type().AssignableTo(dst.Type()):
+    dst.Set(src)
+  case src.Kind() == reflect.Slice && dst.Kind() == reflect.Slice && src.Type().Elem().ConvertibleTo(dst.Type().Elem()):
+    items := reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+
+    for i := 0; i < src.Len(); i++ {
+      items.Index(i).Set(src.Index(i).Convert(dst.Type().Elem()))
+    }
+
+    dst.Set(items)
+  case isNumberKind(src.Kind()) && isNumberKind(dst.Kind()),
+    src.Kind() == reflect.String && dst.Kind() == reflect.String:
+    dst.Set(src.Convert(dst.Type()))
+  default:
+    return fmt.Errorf("%w: can not store %T in %v", ErrFieldType, value, dst.Type())
+  }
+
+  return nil
+}
+
+func isNumberKind(k reflect.Kind) bool {
+  return k >= reflect.Int && k <= reflect.Float64
+}
@@ -0,0 +1,750 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "errors"
+  "fmt"
+  "reflect"
+  "strconv"
+  "strings"
+  "sync"
+  "time"
+)
+
+// Suffix of the key holding the currency of a monetary field.
+const currencyKeySuffix = "_currency"
+
+// Suffix of the key holding the end of a date or time range field.
+const rangeEndKeySuffix = "_until"
+
+// Layout of date field values.
+const fieldDateLayout = "2006-01-02"
+
+// Errors reported when converting custom field values.
+var (
+  ErrUnknownField  = errors.New("pipedrive: unknown field")
+  ErrFieldType     = errors.New("pipedrive: value does not match field type")
+  ErrUnknownOption = errors.New("pipedrive: unknown field option")
+)
+
+// Money represents the value of a monetary field.
+type Money struct {
+  Amount   float64
+  Currency string
+}
+
+// Range represents the value of a date or time range field.
+type Range struct {
+  Start string
+  End   string
+}
+
+// FieldDefinition describes a field of deals, persons, organizations or
+// products, as returned by the field services.
+type FieldDefinition struct {
+  ID        int
+  Key       string
+  Name      string
+  FieldType FieldType
+  Options   []FieldOption
+}
+
+func (f DealField) definition() FieldDefinition {
+  return FieldDefinition{f.ID, f.Key, f.Name, FieldType(f.FieldType), f.Options}
+}
+
+func (f PersonField) definition() FieldDefinition {
+  return FieldDefinition{f.ID, f.Key, f.Name, FieldType(f.FieldType), f.Options}
+}
+
+func (f OrganizationField) definition() FieldDefinition {
+  return FieldDefinition{f.ID, f.Key, f.Name, FieldType(f.FieldType), f.Options}
+}
+
+func (f ProductField) definition() FieldDefinition {
+  return FieldDefinition{f.ID, f.Key, f.Name, FieldType(f.FieldType), f.Options}
+}
+
+func (f ActivityField) definition() FieldDefinition {
+  return FieldDefinition{f.ID, f.Key, f.Name, FieldType(f.FieldType), f.Options}
+}
+
+// CustomFieldResolver reads and writes field values by their human name,
+// converting them according to the field type.
+type CustomFieldResolver struct {
+  fields []FieldDefinition
+  byKey  map[string]int
+  byName map[string]int
+}
+
+// NewCustomFieldResolver returns a resolver for the given fields.
+func NewCustomFieldResolver(fields []FieldDefinition) *CustomFieldResolver {
+  r := &CustomFieldResolver{
+    fields: fields,
+    byKey:  make(map[string]int, len(fields)),
+    byName: make(map[string]int, len(fields)),
+  }
+
+  for i, f := range fields {
+    r.byKey[f.Key] = i
+
+    if _, ok := r.byName[f.Name]; !ok {
+      r.byName[f.Name] = i
+    }
+  }
+
+  return r
+}
+
+// Resolver lists all deal fields and returns a resolver for them.
+func (s *DealFieldsService) Resolver(ctx context.Context) (*CustomFieldResolver, error) {
+  fields, err := s.ListAll(ctx, nil).All()
+
+  if err != nil {
+    return nil, err
+  }
+
+  definitions := make([]FieldDefinition, len(fields))
+
+  for i, f := range fields {
+    definitions[i] = f.definition()
+  }
+
+  return NewCustomFieldResolver(definitions), nil
+}
+
+// Resolver lists all person fields and returns a resolver for them.
+func (s *PersonFieldsService) Resolver(ctx context.Context) (*CustomFieldResolver, error) {
+  fields, err := s.ListAll(ctx, nil).All()
+
+  if err != nil {
+    return nil, err
+  }
+
+  definitions := make([]FieldDefinition, len(fields))
+
+  for i, f := range fields {
+    definitions[i] = f.definition()
+  }
+
+  return NewCustomFieldResolver(definitions), nil
+}
+
+// Resolver lists all organization fields and returns a resolver for them.
+func (s *OrganizationFieldsService) Resolver(ctx context.Context) (*CustomFieldResolver, error) {
+  fields, err := s.ListAll(ctx, nil).All()
+
+  if err != nil {
+    return nil, err
+  }
+
+  definitions := make([]FieldDefinition, len(fields))
+
+  for i, f := range fields {
+    definitions[i] = f.definition()
+  }
+
+  return NewCustomFieldResolver(definitions), nil
+}
+
+// Resolver lists all product fields and returns a resolver for them.
+func (s *ProductFieldsService) Resolver(ctx context.Context) (*CustomFieldResolver, error) {
+  fields, err := s.ListAll(ctx, nil).All()
+
+  if err != nil {
+    return nil, err
+  }
+
+  definitions := make([]FieldDefinition, len(fields))
+
+  for i, f := range fields {
+    definitions[i] = f.definition()
+  }
+
+  return NewCustomFieldResolver(definitions), nil
+}
+
+// Resolver lists all activity fields and returns a resolver for them.
+func (s *ActivityFieldsService) Resolver(ctx context.Context) (*CustomFieldResolver, error) {
+  record, _, err := s.List(ctx)
+
+  if err != nil {
+    return nil, err
+  }
+
+  var definitions []FieldDefinition
+
+  if record != nil {
+    for _, f := range record.Data {
+      definitions = append(definitions, f.definition())
+    }
+  }
+
+  return NewCustomFieldResolver(definitions), nil
+}
+
+// Field looks a field up by name, falling back to a case insensitive
+// match, or by key.
+func (r *CustomFieldResolver) Field(name string) (FieldDefinition, error) {
+  if i, ok := r.byName[name]; ok {
+    return r.fields[i], nil
+  }
+
+  if i, ok := r.byKey[name]; ok {
+    return r.fields[i], nil
+  }
+
+  for _, f := range r.fields {
+    if strings.EqualFold(f.Name, name) {
+      return f, nil
+    }
+  }
+
+  return FieldDefinition{}, fmt.Errorf("%w %q", ErrUnknownField, name)
+}
+
+// hasKey reports whether key holds the value of a field, including the
+// currency of monetary fields and the end of range fields.
+func (r *CustomFieldResolver) hasKey(key string) bool {
+  if _, ok := r.byKey[key]; ok {
+    return true
+  }
+
+  for _, suffix := range []string{currencyKeySuffix, rangeEndKeySuffix} {
+    if _, ok := r.byKey[strings.TrimSuffix(key, suffix)]; ok && strings.HasSuffix(key, suffix) {
+      return true
+    }
+  }
+
+  return false
+}
+
+// fieldByID looks a field up by ID.
+func (r *CustomFieldResolver) fieldByID(id int) (FieldDefinition, bool) {
+  for _, f := range r.fields {
+    if f.ID == id {
+      return f, true
+    }
+  }
+
+  return FieldDefinition{}, false
+}
+
+// Get returns the value of a field from a CustomFields map. Values are
+// converted by field type:
+//
+//   varchar, text, phone, time and others  string
+//   double                                 float64
+//   monetary                               Money
+//   date                                   time.Time
+//   daterange, timerange                   Range
+//   enum                                   string, the option label
+//   set                                    []string, the option labels
+//   user, org, people                      int, the item ID
+//
+// Get returns nil when the field has no value.
+func (r *CustomFieldResolver) Get(values map[string]interface{}, name string) (interface{}, error) {
+  field, err := r.Field(name)
+
+  if err != nil {
+    return nil, err
+  }
+
+  value, ok := values[field.Key]
+
+  if !ok || value == nil || value == "" {
+    return nil, nil
+  }
+
+  switch field.FieldType {
+  case FieldTypeDouble:
+    return toFloat(field, value)
+
+  case FieldTypeMonetary:
+    amount, err := toFloat(field, value)
+
+    if err != nil {
+      return nil, err
+    }
+
+    currency, _ := values[field.Key+currencyKeySuffix].(string)
+
+    return Money{Amount: amount, Currency: currency}, nil
+
+  case FieldTypeDate:
+    s, ok := value.(string)
+
+    if !ok {
+      return nil, typeError(field, value)
+    }
+
+    return time.Parse(fieldDateLayout, s)
+
+  case FieldTypeDaterange, FieldTypeTimerange:
+    end, _ := values[field.Key+rangeEndKeySuffix].(string)
+
+    return Range{Start: fmt.Sprint(value), End: end}, nil
+
+  case FieldTypeEnum:
+    option, err := field.optionByID(fmt.Sprint(normalizeNumber(value)))
+
+    if err != nil {
+      return nil, err
+    }
+
+    return option.Label, nil
+
+  case FieldTypeSet:
+    var labels []string
+
+    for _, id := range strings.Split(fmt.Sprint(normalizeNumber(value)), ",") {
+      option, err := field.optionByID(strings.TrimSpace(id))
+
+      if err != nil {
+        return nil, err
+      }
+
+      labels = append(labels, option.Label)
+    }
+
+    return labels, nil
+
+  case FieldTypeUser, FieldTypeOrg, FieldTypePeople:
+    return toItemID(field, value)
+
+  default:
+    if s, ok := value.(string); ok {
+      return s, nil
+    }
+
+    return value, nil
+  }
+}
+
+// Set converts value to the representation of the API and stores it in
+// values, keyed by the field key. The accepted types mirror the ones
+// returned by Get; enum and set fields also accept option IDs.
+func (r *CustomFieldResolver) Set(values map[string]interface{}, name string, value interface{}) error {
+  if values == nil {
+    return errors.New("values map must not be nil")
+  }
+
+  field, err := r.Field(name)
+
+  if err != nil {
+    return err
+  }
+
+  encoded, err := field.encode(value)
+
+  if err != nil {
+    return err
+  }
+
+  for k, v := range encoded {
+    values[k] = v
+  }
+
+  return nil
+}
+
+// Encode converts values keyed by field name into a payload keyed by field
+// key, reporting the first value that can not be converted.
+func (r *CustomFieldResolver) Encode(values map[string]interface{}) (map[string]interface{}, error) {
+  payload := make(map[string]interface{}, len(values))
+
+  for name, value := range values {
+    if err := r.Set(payload, name, value); err != nil {
+      return nil, err
+    }
+  }
+
+  return payload, nil
+}
+
+// encode converts a Go value into the keys and values sent to the API.
+func (f FieldDefinition) encode(value interface{}) (map[string]interface{}, error) {
+  if value == nil {
+    return map[string]interface{}{f.Key: nil}, nil
+  }
+
+  switch f.FieldType {
+  case FieldTypeVarchar, FieldTypeVarcharAuto, FieldTypeText, FieldTypePhone, FieldTypeTime:
+    s, ok := value.(string)
+
+    if !ok {
+      return nil, typeError(f, value)
+    }
+
+    return map[string]interface{}{f.Key: s}, nil
+
+  case FieldTypeDouble:
+    n, ok := numberValue(value)
+
+    if !ok {
+      return nil, typeError(f, value)
+    }
+
+    return map[string]interface{}{f.Key: n}, nil
+
+  case FieldTypeMonetary:
+    if money, ok := value.(Money); ok {
+      return map[string]interface{}{f.Key: money.Amount, f.Key + currencyKeySuffix: money.Currency}, nil
+    }
+
+    n, ok := numberValue(value)
+
+    if !ok {
+      return nil, typeError(f, value)
+    }
+
+    return map[string]interface{}{f.Key: n}, nil
+
+  case FieldTypeDate:
+    switch v := value.(type) {
+    case time.Time:
+      return map[string]interface{}{f.Key: v.Format(fieldDateLayout)}, nil
+    case string:
+      if _, err := time.Parse(fieldDateLayout, v); err != nil {
+        return nil, fmt.Errorf("%w: field %q expects a YYYY-MM-DD date, got %q", ErrFieldType, f.Name, v)
+      }
+
+      return map[string]interface{}{f.Key: v}, nil
+    }
+
+    return nil, typeError(f, value)
+
+  case FieldTypeDaterange, FieldTypeTimerange:
+    v, ok := value.(Range)
+
+    if !ok {
+      return nil, typeError(f, value)
+    }
+
+    return map[string]interface{}{f.Key: v.Start, f.Key + rangeEndKeySuffix: v.End}, nil
+
+  case FieldTypeEnum:
+    option, err := f.option(value)
+
+    if err != nil {
+      return nil, err
+    }
+
+    return map[string]interface{}{f.Key: option.ID.value()}, nil
+
+  case FieldTypeSet:
+    var items []interface{}
+
+    switch v := value.(type) {
+    case []string:
+      for _, item := range v {
+        items = append(items, item)
+      }
+    case []int:
+      for _, item := range v {
+        items = append(items, item)
+      }
+    default:
+      return nil, typeError(f, value)
+    }
+
+    ids := make([]string, len(items))
+
+    for i, item := range items {
+      option, err := f.option(item)
+
+      if err != nil {
+        return nil, err
+      }
+
+      ids[i] = string(option.ID)
+    }
+
+    return map[string]interface{}{f.Key: strings.Join(ids, ",")}, nil
+
+  case FieldTypeUser, FieldTypeOrg, FieldTypePeople:
+    n, ok := numberValue(value)
+
+    if !ok || n != float64(int(n)) {
+      return nil, typeError(f, value)
+    }
+
+    return map[string]interface{}{f.Key: int(n)}, nil
+
+  default:
+    return map[string]interface{}{f.Key: value}, nil
+  }
+}
+
+// option finds an option by label or by ID. IDs may be given as a
+// FieldOptionID or as any whole number, such as IDs decoded from JSON.
+func (f FieldDefinition) option(value interface{}) (FieldOption, error) {
+  switch v := value.(type) {
+  case string:
+    for _, o := range f.Options {
+      if o.Label == v {
+        return o, nil
+      }
+    }
+
+    return f.optionByID(v)
+  case FieldOptionID:
+    return f.optionByID(string(v))
+  }
+
+  if n, ok := numberValue(value); ok && n == float64(int64(n)) {
+    return f.optionByID(strconv.FormatInt(int64(n), 10))
+  }
+
+  return FieldOption{}, typeError(f, value)
+}
+
+func (f FieldDefinition) optionByID(id string) (FieldOption, error) {
+  for _, o := range f.Options {
+    if string(o.ID) == id {
+      return o, nil
+    }
+  }
+
+  return FieldOption{}, fmt.Errorf("%w %q for field %q", ErrUnknownOption, id, f.Name)
+}
+
+// value returns the option ID as sent to the API.
+func (id FieldOptionID) value() interface{} {
+  if n, err := id.Int(); err == nil {
+    return n
+  }
+
+  return string(id)
+}
+
+func typeError(f FieldDefinition, value interface{}) error {
+  return fmt.Errorf("%w: field %q of type %v does not accept %T", ErrFieldType, f.Name, f.FieldType, value)
+}
+
+// numberValue converts any numeric kind to float64.
+func numberValue(value interface{}) (float64, bool) {
+  v := reflect.ValueOf(value)
+
+  switch v.Kind() {
+  case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+    return float64(v.Int()), true
+  case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+    return float64(v.Uint()), true
+  case reflect.Float32, reflect.Float64:
+    return v.Float(), true
+  }
+
+  return 0, false
+}
+
+// normalizeNumber prints whole float64 values decoded from JSON as integers.
+func normalizeNumber(value interface{}) interface{} {
+  if f, ok := value.(float64); ok && f == float64(int64(f)) {
+    return int64(f)
+  }
+
+  return value
+}
+
+func toFloat(f FieldDefinition, value interface{}) (float64, error) {
+  switch v := value.(type) {
+  case float64:
+    return v, nil
+  case string:
+    n, err := strconv.ParseFloat(v, 64)
+
+    if err != nil {
+      return 0, typeError(f, value)
+    }
+
+    return n, nil
+  }
+
+  return 0, typeError(f, value)
+}
+
+// toItemID reads the ID of a user, organization or person field, which
+// Pipedrive returns either as a number or as an object with a value key.
+func toItemID(f FieldDefinition, value interface{}) (int, error) {
+  switch v := value.(type) {
+  case float64:
+    return int(v), nil
+  case map[string]interface{}:
+    for _, key := range []string{"value", "id"} {
+      if id, ok := v[key].(float64); ok {
+        return int(id), nil
+      }
+    }
+  }
+
+  return 0, typeError(f, value)
+}
+
+// Known JSON keys of the structs holding custom fields, by type.
+var knownJSONKeys sync.Map
+
+func jsonKeys(t reflect.Type) map[string]bool {
+  if keys, ok := knownJSONKeys.Load(t); ok {
+    return keys.(map[string]bool)
+  }
+
+  keys := make(map[string]bool, t.NumField())
+
+  for i := 0; i < t.NumField(); i++ {
+    name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+
+    if name == "" {
+      name = t.Field(i).Name
+    }
+
+    keys[name] = true
+  }
+
+  knownJSONKeys.Store(t, keys)
+
+  return keys
+}
+
+// unmarshalCustomFields decodes data into v, a pointer to a struct, and
+// returns the values of the keys v does not declare.
+func unmarshalCustomFields(data []byte, v interface{}) (map[string]interface{}, error) {
+  if err := json.Unmarshal(data, v); err != nil {
+    return nil, err
+  }
+
+  var all map[string]interface{}
+
+  if err := json.Unmarshal(data, &all); err != nil {
+    return nil, err
+  }
+
+  known := jsonKeys(reflect.TypeOf(v).Elem())
+  var custom map[string]interface{}
+
+  for key, value := range all {
+    if known[key] {
+      continue
+    }
+
+    if custom == nil {
+      custom = make(map[string]interface{})
+    }
+
+    custom[key] = value
+  }
+
+  return custom, nil
+}
+
+// marshalCustomFields encodes v and adds the custom field values to it.
+func marshalCustomFields(v interface{}, custom map[string]interface{}) ([]byte, error) {
+  data, err := json.Marshal(v)
+
+  if err != nil || len(custom) == 0 {
+    return data, err
+  }
+
+  var all map[string]interface{}
+
+  if err := json.Unmarshal(data, &all); err != nil {
+    return nil, err
+  }
+
+  for key, value := range custom {
+    if _, ok := all[key]; !ok {
+      all[key] = value
+    }
+  }
+
+  return json.Marshal(all)
+}
+
+func (d *Deal) UnmarshalJSON(data []byte) error {
+  type deal Deal
+  var v deal
+
+  custom, err := unmarshalCustomFields(data, &v)
+
+  if err != nil {
+    return err
+  }
+
+  *d = Deal(v)
+  d.CustomFields = custom
+
+  return nil
+}
+
+func (d Deal) MarshalJSON() ([]byte, error) {
+  type deal Deal
+
+  return marshalCustomFields(deal(d), d.CustomFields)
+}
+
+func (p *Person) UnmarshalJSON(data []byte) error {
+  type person Person
+  var v person
+
+  custom, err := unmarshalCustomFields(data, &v)
+
+  if err != nil {
+    return err
+  }
+
+  *p = Person(v)
+  p.CustomFields = custom
+
+  return nil
+}
+
+func (p Person) MarshalJSON() ([]byte, error) {
+  type person Person
+
+  return marshalCustomFields(person(p), p.CustomFields)
+}
+
+func (o *Organization) UnmarshalJSON(data []byte) error {
+  type organization Organization
+  var v organization
+
+  custom, err := unmarshalCustomFields(data, &v)
+
+  if err != nil {
+    return err
+  }
+
+  *o = Organization(v)
+  o.CustomFields = custom
+
+  return nil
+}
+
+func (o Organization) MarshalJSON() ([]byte, error) {
+  type organization Organization
+
+  return marshalCustomFields(organization(o), o.CustomFields)
+}
+
+func (p *Product) UnmarshalJSON(data []byte) error {
+  type product Product
+  var v product
+
+  custom, err := unmarshalCustomFields(data, &v)
+
+  if err != nil {
+    return err
+  }
+
+  *p = Product(v)
+  p.CustomFields = custom
+
+  return nil
+}
+
+func (p Product) MarshalJSON() ([]byte, error) {
+  type product Product
+
+  return marshalCustomFields(product(p), p.CustomFields)
+}
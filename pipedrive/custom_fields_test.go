@@ -0,0 +1,168 @@
+package pipedrive
+
+import (
+  "encoding/json"
+  "errors"
+  "reflect"
+  "testing"
+)
+
+var testDealFields = []FieldDefinition{
+  {Key: "abc", Name: "Budget", FieldType: FieldTypeMonetary},
+  {Key: "def", Name: "Region", FieldType: FieldTypeEnum, Options: []FieldOption{{ID: "1", Label: "EMEA"}, {ID: "2", Label: "APAC"}}},
+  {Key: "ghi", Name: "Tags", FieldType: FieldTypeSet, Options: []FieldOption{{ID: "3", Label: "Hot"}, {ID: "4", Label: "Partner"}}},
+  {Key: "jkl", Name: "Account manager", FieldType: FieldTypeUser},
+}
+
+func TestDeal_customFieldsRoundTrip(t *testing.T) {
+  var deal Deal
+
+  data := `{"id":7,"title":"Deal","abc":1200,"abc_currency":"EUR","def":2,"ghi":"3,4","jkl":{"id":12,"value":12}}`
+
+  if err := json.Unmarshal([]byte(data), &deal); err != nil {
+    t.Fatalf("Unmarshal returned error: %v", err)
+  }
+
+  if deal.ID != 7 || len(deal.CustomFields) != 5 {
+    t.Fatalf("Unmarshal returned %+v", deal)
+  }
+
+  resolver := NewCustomFieldResolver(testDealFields)
+
+  tests := []struct {
+    name string
+    want interface{}
+  }{
+    {"Budget", Money{Amount: 1200, Currency: "EUR"}},
+    {"region", "APAC"},
+    {"Tags", []string{"Hot", "Partner"}},
+    {"Account manager", 12},
+  }
+
+  for _, tt := range tests {
+    got, err := resolver.Get(deal.CustomFields, tt.name)
+
+    if err != nil {
+      t.Fatalf("Get(%q) returned error: %v", tt.name, err)
+    }
+
+    if !reflect.DeepEqual(got, tt.want) {
+      t.Errorf("Get(%q) = %#v, want %#v", tt.name, got, tt.want)
+    }
+  }
+
+  encoded, err := json.Marshal(deal)
+
+  if err != nil {
+    t.Fatalf("Marshal returned error: %v", err)
+  }
+
+  var fields map[string]interface{}
+  json.Unmarshal(encoded, &fields)
+
+  if fields["ghi"] != "3,4" || fields["title"] != "Deal" {
+    t.Errorf("Marshal returned %s", encoded)
+  }
+}
+
+func TestCustomFieldResolver_Encode(t *testing.T) {
+  resolver := NewCustomFieldResolver(testDealFields)
+
+  payload, err := resolver.Encode(map[string]interface{}{
+    "Budget": Money{Amount: 10, Currency: "USD"},
+    "Region": "EMEA",
+    "Tags":   []string{"Partner"},
+  })
+
+  if err != nil {
+    t.Fatalf("Encode returned error: %v", err)
+  }
+
+  want := map[string]interface{}{"abc": 10.0, "abc_currency": "USD", "def": 1, "ghi": "4"}
+
+  if !reflect.DeepEqual(payload, want) {
+    t.Errorf("Encode = %#v, want %#v", payload, want)
+  }
+
+  body, _ := json.Marshal(&DealCreateOptions{Title: "Deal", CustomFields: payload})
+
+  var fields map[string]interface{}
+  json.Unmarshal(body, &fields)
+
+  if fields["title"] != "Deal" || fields["ghi"] != "4" {
+    t.Errorf("DealCreateOptions encoded as %s", body)
+  }
+
+  if _, err := resolver.Encode(map[string]interface{}{"Region": "LATAM"}); !errors.Is(err, ErrUnknownOption) {
+    t.Errorf("Encode with unknown option returned %v, want ErrUnknownOption", err)
+  }
+
+  if _, err := resolver.Encode(map[string]interface{}{"Budget": "lots"}); !errors.Is(err, ErrFieldType) {
+    t.Errorf("Encode with a string budget returned %v, want ErrFieldType", err)
+  }
+
+  if _, err := resolver.Encode(map[string]interface{}{"Missing": 1}); !errors.Is(err, ErrUnknownField) {
+    t.Errorf("Encode with unknown field returned %v, want ErrUnknownField", err)
+  }
+}
+
+func TestFieldDefinition_option(t *testing.T) {
+  field := testDealFields[1]
+
+  for _, value := range []interface{}{"APAC", "2", FieldOptionID("2"), 2, int32(2), int64(2), uint8(2), 2.0} {
+    if option, err := field.option(value); err != nil || option.Label != "APAC" {
+      t.Errorf("option(%#v) = %v, %v, want APAC", value, option, err)
+    }
+  }
+
+  if _, err := field.option(2.5); !errors.Is(err, ErrFieldType) {
+    t.Errorf("option(2.5) returned %v, want ErrFieldType", err)
+  }
+
+  if _, err := field.option(int64(9)); !errors.Is(err, ErrUnknownOption) {
+    t.Errorf("option(9) returned %v, want ErrUnknownOption", err)
+  }
+}
+
+type testContract struct {
+  Region  string   `pipedrive:"Region"`
+  Tags    []string `pipedrive:"Tags,omitempty"`
+  Manager *int     `pipedrive:"Account manager"`
+  Budget  float64  `pipedrive:"-"`
+}
+
+func TestCustomFieldResolver_MarshalStruct(t *testing.T) {
+  resolver := NewCustomFieldResolver(testDealFields)
+
+  values, err := resolver.Marshal(testContract{Region: "EMEA"})
+
+  if err != nil {
+    t.Fatalf("Marshal returned error: %v", err)
+  }
+
+  want := map[string]interface{}{"def": 1, "jkl": nil}
+
+  if !reflect.DeepEqual(values, want) {
+    t.Errorf("Marshal = %#v, want %#v", values, want)
+  }
+
+  var contract testContract
+
+  err = resolver.Unmarshal(map[string]interface{}{"def": 2.0, "ghi": "3", "jkl": 5.0}, &contract)
+
+  if err != nil {
+    t.Fatalf("Unmarshal returned error: %v", err)
+  }
+
+  if contract.Region != "APAC" || len(contract.Tags) != 1 || contract.Tags[0] != "Hot" || *contract.Manager != 5 {
+    t.Errorf("Unmarshal returned %+v", contract)
+  }
+
+  _, err = resolver.Marshal(struct {
+    Budget string `pipedrive:"Budget"`
+  }{"high"})
+
+  if !errors.Is(err, ErrFieldType) {
+    t.Errorf("Marshal with a string budget returned %v, want ErrFieldType", err)
+  }
+}
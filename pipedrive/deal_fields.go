@@ -36,10 +36,7 @@ type DealField struct {
   Link               string      `json:"link,omitempty"`
   MandatoryFlag      bool        `json:"mandatory_flag"`
   IsSubfield         bool        `json:"is_subfield,omitempty"`
-  Options            []struct {
-    ID    string `json:"id"`
-    Label string `json:"label"`
-  } `json:"options,omitempty"`
+  Options            []FieldOption `json:"options,omitempty"`
   BulkEditAllowedConditions struct {
     Status string `json:"status"`
   } `json:"bulk_edit_allowed_conditions,omitempty"`
@@ -67,7 +64,7 @@ type DealFieldResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/get_dealFields
 func (s *DealFieldsService) List(ctx context.Context) (*DealFieldsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/dealFields", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/dealFields", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -84,12 +81,33 @@ func (s *DealFieldsService) List(ctx context.Context) (*DealFieldsResponse, *Res
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all deal fields.
+func (s *DealFieldsService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[DealField] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]DealField, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/dealFields", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *DealFieldsResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // GetByID returns specific deal field.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/get_dealFields_id
 func (s *DealFieldsService) GetByID(ctx context.Context, id int) (*DealFieldResponse, *Response, error) {
   uri := fmt.Sprintf("/dealFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -118,7 +136,7 @@ type DealFieldCreateOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/post_dealFields
 func (s *DealFieldsService) Create(ctx context.Context, opt *DealFieldCreateOptions) (*DealFieldResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/dealFields", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/dealFields", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -147,7 +165,7 @@ type DealFieldUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/put_dealFields_id
 func (s *DealFieldsService) Update(ctx context.Context, id int, opt *DealFieldUpdateOptions) (*ProductFieldResponse, *Response, error) {
   uri := fmt.Sprintf("/dealFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -168,7 +186,7 @@ func (s *DealFieldsService) Update(ctx context.Context, id int, opt *DealFieldUp
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/delete_dealFields
 func (s *DealFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/dealFields", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/dealFields", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -184,7 +202,7 @@ func (s *DealFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*Res
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/delete_dealFields_id
 func (s *DealFieldsService) Delete(ctx context.Context, id uint) (*Response, error) {
   uri := fmt.Sprintf("/dealFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
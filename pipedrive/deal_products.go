@@ -0,0 +1,287 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+)
+
+// DealProduct represents a product attached to a deal, a line item.
+type DealProduct struct {
+  ID                 int     `json:"id"`
+  DealID             int     `json:"deal_id"`
+  OrderNr            int     `json:"order_nr"`
+  ProductID          int     `json:"product_id"`
+  ProductVariationID *int    `json:"product_variation_id"`
+  Name               string  `json:"name"`
+  ItemPrice          float64 `json:"item_price"`
+  Quantity           float64 `json:"quantity"`
+  DiscountPercentage float64 `json:"discount_percentage"`
+  Duration           float64 `json:"duration"`
+  DurationUnit       string  `json:"duration_unit"`
+  Tax                float64 `json:"tax"`
+  Sum                float64 `json:"sum"`
+  Currency           string  `json:"currency"`
+  Comments           string  `json:"comments"`
+  EnabledFlag        bool    `json:"enabled_flag"`
+  ActiveFlag         bool    `json:"active_flag"`
+  AddTime            string  `json:"add_time"`
+  LastEdit           string  `json:"last_edit"`
+}
+
+func (p DealProduct) String() string {
+  return Stringify(p)
+}
+
+// DealProductsResponse represents the products attached to a deal.
+type DealProductsResponse struct {
+  Success        bool           `json:"success"`
+  Data           []DealProduct  `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// DealProductResponse represents single deal product response.
+type DealProductResponse struct {
+  Success bool        `json:"success"`
+  Data    DealProduct `json:"data"`
+}
+
+// DealProductsListOptions specifices the optional parameters to the
+// DealService.ListProducts method.
+type DealProductsListOptions struct {
+  IncludeProductData uint8 `url:"include_product_data,omitempty"`
+
+  ListOptions
+}
+
+// ListProducts lists the products attached to a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealProducts
+func (s *DealService) ListProducts(ctx context.Context, id int, opt *DealProductsListOptions) (*DealProductsResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/products", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealProductsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllProducts returns an iterator over all products attached to a deal.
+func (s *DealService) ListAllProducts(ctx context.Context, id int, opt *ListOptions) *Iterator[DealProduct] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]DealProduct, *AdditionalData, *Response, error) {
+    record, resp, err := s.ListProducts(ctx, id, &DealProductsListOptions{ListOptions: *page})
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// DealProductAddOptions specifices the parameters to the
+// DealService.AddProduct method.
+type DealProductAddOptions struct {
+  ProductID          int     `json:"product_id"`
+  ItemPrice          float64 `json:"item_price"`
+  Quantity           float64 `json:"quantity"`
+  DiscountPercentage float64 `json:"discount_percentage,omitempty"`
+  Duration           float64 `json:"duration,omitempty"`
+  ProductVariationID int     `json:"product_variation_id,omitempty"`
+  Comments           string  `json:"comments,omitempty"`
+  Tax                float64 `json:"tax,omitempty"`
+
+  // Whether the product is included in the deal value. Defaults to true.
+  EnabledFlag *bool `json:"enabled_flag,omitempty"`
+}
+
+// AddProduct attaches a product to a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#addDealProduct
+func (s *DealService) AddProduct(ctx context.Context, id int, opt *DealProductAddOptions) (*DealProductResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/products", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealProductResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// DealProductUpdateOptions specifices the optional parameters to the
+// DealService.UpdateProduct method. Only the set fields are changed.
+type DealProductUpdateOptions struct {
+  ItemPrice          *float64 `json:"item_price,omitempty"`
+  Quantity           *float64 `json:"quantity,omitempty"`
+  DiscountPercentage *float64 `json:"discount_percentage,omitempty"`
+  Duration           *float64 `json:"duration,omitempty"`
+  ProductVariationID *int     `json:"product_variation_id,omitempty"`
+  Comments           *string  `json:"comments,omitempty"`
+  Tax                *float64 `json:"tax,omitempty"`
+  EnabledFlag        *bool    `json:"enabled_flag,omitempty"`
+}
+
+// UpdateProduct updates a product attached to a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#updateDealProduct
+func (s *DealService) UpdateProduct(ctx context.Context, dealID int, productAttachmentID int, opt *DealProductUpdateOptions) (*DealProductResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/products/%v", dealID, productAttachmentID)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealProductResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// DealProductsReconcileResult lists the changes made by
+// DealService.ReconcileProducts.
+type DealProductsReconcileResult struct {
+  Added     []DealProduct
+  Updated   []DealProduct
+  Removed   []DealProduct
+  Unchanged []DealProduct
+}
+
+// ReconcileProducts changes the line items of a deal to match desired.
+// Attached products are matched to desired items by product and variation,
+// in order; matched items are updated when they differ, missing ones are
+// added and the remaining attachments are removed.
+//
+// Changes are applied one by one. On error the result holds the changes
+// applied so far.
+func (s *DealService) ReconcileProducts(ctx context.Context, id int, desired []DealProductAddOptions) (*DealProductsReconcileResult, error) {
+  current, err := s.ListAllProducts(ctx, id, nil).All()
+
+  if err != nil {
+    return nil, err
+  }
+
+  result := &DealProductsReconcileResult{}
+  matched := make([]bool, len(current))
+
+  for i := range desired {
+    want := &desired[i]
+    match := -1
+
+    for j, item := range current {
+      if !matched[j] && item.ProductID == want.ProductID && variationID(item.ProductVariationID) == want.ProductVariationID {
+        match = j
+        break
+      }
+    }
+
+    if match < 0 {
+      record, _, err := s.AddProduct(ctx, id, want)
+
+      if err != nil {
+        return result, err
+      }
+
+      if record != nil {
+        result.Added = append(result.Added, record.Data)
+      }
+
+      continue
+    }
+
+    matched[match] = true
+    item := current[match]
+    update, changed := dealProductChanges(item, want)
+
+    if !changed {
+      result.Unchanged = append(result.Unchanged, item)
+      continue
+    }
+
+    record, _, err := s.UpdateProduct(ctx, id, item.ID, update)
+
+    if err != nil {
+      return result, err
+    }
+
+    if record != nil {
+      result.Updated = append(result.Updated, record.Data)
+    }
+  }
+
+  for j, item := range current {
+    if matched[j] {
+      continue
+    }
+
+    if _, err := s.DeleteAttachedProduct(ctx, id, item.ID); err != nil {
+      return result, err
+    }
+
+    result.Removed = append(result.Removed, item)
+  }
+
+  return result, nil
+}
+
+func variationID(id *int) int {
+  if id == nil {
+    return 0
+  }
+
+  return *id
+}
+
+// dealProductChanges returns the update turning item into want, and
+// whether they differ.
+func dealProductChanges(item DealProduct, want *DealProductAddOptions) (*DealProductUpdateOptions, bool) {
+  duration := want.Duration
+
+  if duration == 0 {
+    duration = 1
+  }
+
+  enabled := want.EnabledFlag == nil || *want.EnabledFlag
+
+  changed := item.ItemPrice != want.ItemPrice ||
+    item.Quantity != want.Quantity ||
+    item.DiscountPercentage != want.DiscountPercentage ||
+    item.Duration != duration ||
+    item.Tax != want.Tax ||
+    item.Comments != want.Comments ||
+    item.EnabledFlag != enabled
+
+  return &DealProductUpdateOptions{
+    ItemPrice:          &want.ItemPrice,
+    Quantity:           &want.Quantity,
+    DiscountPercentage: &want.DiscountPercentage,
+    Duration:           &duration,
+    Comments:           &want.Comments,
+    Tax:                &want.Tax,
+    EnabledFlag:        &enabled,
+  }, changed
+}
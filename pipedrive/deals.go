@@ -4,6 +4,7 @@ import (
   "context"
   "fmt"
   "net/http"
+  "strings"
 )
 
 // DealService handles deals related
@@ -70,7 +71,9 @@ type Deal struct {
   CcEmail                        string        `json:"cc_email,omitempty"`
   OrgHidden                      bool          `json:"org_hidden,omitempty"`
   PersonHidden                   bool          `json:"person_hidden,omitempty"`
-  Eight02Aa45Ecc05F31Fcebe8B706510389F56B7A041 interface{} `json:"802aa45ecc05f31fcebe8b706510389f56b7a041,omitempty"`
+
+  // Values of keys not modelled above, mostly custom fields keyed by their hash.
+  CustomFields map[string]interface{} `json:"-"`
 }
 
 type  CreatorUserID struct {
@@ -138,18 +141,19 @@ type DealResponse struct {
   AdditionalData AdditionalData `json:"additional_data,omitempty,omitempty"`
 }
 
-// ListUpdates about a deal.
+// ListUpdates lists the changelog of a deal: field changes, notes,
+// activities, files and emails.
 //
-// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals_id_flow
-func (s *DealService) ListUpdates(ctx context.Context, id int) (*DealsResponse, *Response, error) {
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealUpdates
+func (s *DealService) ListUpdates(ctx context.Context, id int, opt *FlowOptions) (*FlowResponse, *Response, error) {
   uri := fmt.Sprintf("/deals/%v/flow", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
 
   if err != nil {
     return nil, nil, err
   }
 
-  var record *DealsResponse
+  var record *FlowResponse
 
   resp, err := s.client.Do(ctx, req, &record)
 
@@ -160,11 +164,33 @@ func (s *DealService) ListUpdates(ctx context.Context, id int) (*DealsResponse,
   return record, resp, nil
 }
 
+// ListAllUpdates returns an iterator over the whole changelog of a deal.
+func (s *DealService) ListAllUpdates(ctx context.Context, id int, opt *FlowOptions) *Iterator[FlowItem] {
+  var filters FlowOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]FlowItem, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.ListUpdates(ctx, id, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // Find deals by name.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals_find
 func (s *DealService) Find(ctx context.Context, term string) (*DealsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/deals/find", &SearchOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/deals/find", &SearchOptions{
     Term: term,
   }, nil)
 
@@ -183,11 +209,50 @@ func (s *DealService) Find(ctx context.Context, term string) (*DealsResponse, *R
   return record, resp, nil
 }
 
+// DealStatus is the status deals are filtered by.
+type DealStatus string
+
+const (
+  DealStatusOpen          DealStatus = "open"
+  DealStatusWon           DealStatus = "won"
+  DealStatusLost          DealStatus = "lost"
+  DealStatusDeleted       DealStatus = "deleted"
+  DealStatusAllNotDeleted DealStatus = "all_not_deleted"
+)
+
+// DealsListOptions specifices the optional parameters to the
+// DealService.List method.
+type DealsListOptions struct {
+  UserID     uint       `url:"user_id,omitempty"`
+  FilterID   uint       `url:"filter_id,omitempty"`
+  StageID    uint       `url:"stage_id,omitempty"`
+  Status     DealStatus `url:"status,omitempty"`
+  OwnedByYou uint8      `url:"owned_by_you,omitempty"`
+
+  // Field names and sorting mode, e.g. "title ASC, value DESC".
+  Sort string `url:"sort,omitempty"`
+
+  // Fields returned for each deal, e.g. []string{"id", "title", "value"}.
+  // All fields are returned when empty.
+  Fields []string `url:"-"`
+
+  ListOptions
+}
+
+// dealsPath returns the deals collection path with the field selector.
+func dealsPath(opt *DealsListOptions) string {
+  if opt == nil || len(opt.Fields) == 0 {
+    return "/deals"
+  }
+
+  return "/deals:(" + strings.Join(opt.Fields, ",") + ")"
+}
+
 // List all deals.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals
-func (s *DealService) List(ctx context.Context) (*DealsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/deals", nil, nil)
+func (s *DealService) List(ctx context.Context, opt *DealsListOptions) (*DealsResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, dealsPath(opt), opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -204,12 +269,166 @@ func (s *DealService) List(ctx context.Context) (*DealsResponse, *Response, erro
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all deals matching opt.
+func (s *DealService) ListAll(ctx context.Context, opt *DealsListOptions) *Iterator[Deal] {
+  var filters DealsListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]Deal, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.List(ctx, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// DealsSummaryOptions specifices the optional parameters to the
+// DealService.Summary method.
+type DealsSummaryOptions struct {
+  Status   DealStatus `url:"status,omitempty"`
+  FilterID uint       `url:"filter_id,omitempty"`
+  UserID   uint       `url:"user_id,omitempty"`
+  StageID  uint       `url:"stage_id,omitempty"`
+}
+
+// DealsSummaryValue represents the deals total of a single currency.
+type DealsSummaryValue struct {
+  Count                   int     `json:"count"`
+  Value                   float64 `json:"value"`
+  ValueConverted          float64 `json:"value_converted"`
+  ValueFormatted          string  `json:"value_formatted"`
+  ValueConvertedFormatted string  `json:"value_converted_formatted"`
+}
+
+// DealsSummary represents the totals of deals, keyed by currency code.
+type DealsSummary struct {
+  ValuesTotal                                  map[string]DealsSummaryValue `json:"values_total"`
+  WeightedValuesTotal                          map[string]DealsSummaryValue `json:"weighted_values_total"`
+  TotalCount                                   int                          `json:"total_count"`
+  TotalCurrencyConvertedValue                  float64                      `json:"total_currency_converted_value"`
+  TotalWeightedCurrencyConvertedValue          float64                      `json:"total_weighted_currency_converted_value"`
+  TotalCurrencyConvertedValueFormatted         string                       `json:"total_currency_converted_value_formatted"`
+  TotalWeightedCurrencyConvertedValueFormatted string                       `json:"total_weighted_currency_converted_value_formatted"`
+}
+
+// DealsSummaryResponse represents deals summary response.
+type DealsSummaryResponse struct {
+  Success bool         `json:"success"`
+  Data    DealsSummary `json:"data"`
+}
+
+// Summary returns the totals of deals per currency.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealsSummary
+func (s *DealService) Summary(ctx context.Context, opt *DealsSummaryOptions) (*DealsSummaryResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/deals/summary", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealsSummaryResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// DealsTimelineInterval is the length of a deals timeline period.
+type DealsTimelineInterval string
+
+const (
+  DealsTimelineIntervalDay     DealsTimelineInterval = "day"
+  DealsTimelineIntervalWeek    DealsTimelineInterval = "week"
+  DealsTimelineIntervalMonth   DealsTimelineInterval = "month"
+  DealsTimelineIntervalQuarter DealsTimelineInterval = "quarter"
+)
+
+// DealsTimelineOptions specifices the parameters to the
+// DealService.Timeline method.
+type DealsTimelineOptions struct {
+  // First day of the timeline, formatted as YYYY-MM-DD.
+  StartDate string                `url:"start_date"`
+  Interval  DealsTimelineInterval `url:"interval"`
+  Amount    uint                  `url:"amount"`
+
+  // Date field deals are grouped by, e.g. "add_time" or "won_time".
+  FieldKey string `url:"field_key"`
+
+  UserID                uint   `url:"user_id,omitempty"`
+  PipelineID            uint   `url:"pipeline_id,omitempty"`
+  FilterID              uint   `url:"filter_id,omitempty"`
+  ExcludeDeals          uint8  `url:"exclude_deals,omitempty"`
+  TotalsConvertCurrency string `url:"totals_convert_currency,omitempty"`
+}
+
+// DealsTimelineTotals represents the deals totals of a period. Values are
+// keyed by currency code.
+type DealsTimelineTotals struct {
+  Count              int                `json:"count"`
+  Values             map[string]float64 `json:"values"`
+  WeightedValues     map[string]float64 `json:"weighted_values"`
+  OpenCount          int                `json:"open_count"`
+  OpenValues         map[string]float64 `json:"open_values"`
+  WeightedOpenValues map[string]float64 `json:"weighted_open_values"`
+  WonCount           int                `json:"won_count"`
+  WonValues          map[string]float64 `json:"won_values"`
+}
+
+// DealsTimelinePeriod represents the deals of a single timeline period.
+type DealsTimelinePeriod struct {
+  PeriodStart string              `json:"period_start"`
+  PeriodEnd   string              `json:"period_end"`
+  Deals       []Deal              `json:"deals"`
+  Totals      DealsTimelineTotals `json:"totals"`
+}
+
+// DealsTimelineResponse represents deals timeline response.
+type DealsTimelineResponse struct {
+  Success bool                  `json:"success"`
+  Data    []DealsTimelinePeriod `json:"data"`
+}
+
+// Timeline returns deals grouped into periods by a date field.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealsTimeline
+func (s *DealService) Timeline(ctx context.Context, opt *DealsTimelineOptions) (*DealsTimelineResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/deals/timeline", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealsTimelineResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
 // Duplicate a deal.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/post_deals_id_duplicate
 func (s *DealService) Duplicate(ctx context.Context, id int) (*DealResponse, *Response, error) {
   uri := fmt.Sprintf("/deals/%v/duplicate", id)
-  req, err := s.client.NewRequest(http.MethodPost, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -237,7 +456,7 @@ type DealsMergeOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/put_deals_id_merge
 func (s *DealService) Merge(ctx context.Context, id int, opt *DealsMergeOptions) (*Response, error) {
   uri := fmt.Sprintf("/deals/%v/merge", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, err
@@ -261,13 +480,22 @@ type DealCreateOptions struct {
   Status            string    `json:"status,omitempty"`
   LostReason        string    `json:"lost_reason,omitempty"`
   AddTime           string    `json:"add_time,omitempty"`
+
+  // Custom field values keyed by field key, see CustomFieldResolver.
+  CustomFields map[string]interface{} `json:"-"`
+}
+
+func (o DealCreateOptions) MarshalJSON() ([]byte, error) {
+  type dealCreateOptions DealCreateOptions
+
+  return marshalCustomFields(dealCreateOptions(o), o.CustomFields)
 }
 
 // Add a deal.
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#addDeal
 func (s *DealService) Add(ctx context.Context, opt *DealCreateOptions) (*DealResponse, *Response, error) {
   uri := fmt.Sprintf("/deals")
-  req, err := s.client.NewRequest(http.MethodPost, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -297,6 +525,15 @@ type DealsUpdateOptions struct {
   Status         string `json:"status,omitempty,omitempty"`
   LostReason     string `json:"lost_reason,omitempty,omitempty"`
   VisibleTo      uint   `json:"visible_to,omitempty,omitempty"`
+
+  // Custom field values keyed by field key, see CustomFieldResolver.
+  CustomFields map[string]interface{} `json:"-"`
+}
+
+func (o DealsUpdateOptions) MarshalJSON() ([]byte, error) {
+  type dealsUpdateOptions DealsUpdateOptions
+
+  return marshalCustomFields(dealsUpdateOptions(o), o.CustomFields)
 }
 
 
@@ -304,7 +541,7 @@ type DealsUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/put_deals_id
 func (s *DealService) Update(ctx context.Context, id int, opt *DealsUpdateOptions) (*Response, error) {
   uri := fmt.Sprintf("/deals/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, err
@@ -318,7 +555,7 @@ func (s *DealService) Update(ctx context.Context, id int, opt *DealsUpdateOption
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/delete_deals_id_followers_follower_id
 func (s *DealService) DeleteFollower(ctx context.Context, id int, followerID int) (*Response, error) {
   uri := fmt.Sprintf("/deals/%v/followers/%v", id, followerID)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -331,7 +568,7 @@ func (s *DealService) DeleteFollower(ctx context.Context, id int, followerID int
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/delete_deals
 func (s *DealService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/deals", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/deals", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -347,7 +584,7 @@ func (s *DealService) DeleteMultiple(ctx context.Context, ids []int) (*Response,
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/delete_deals_id_participants_deal_participant_id
 func (s *DealService) DeleteParticipant(ctx context.Context, dealID int, participantID int) (*Response, error) {
   uri := fmt.Sprintf("/deals/%v/participants/%v", dealID, participantID)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -361,7 +598,7 @@ func (s *DealService) DeleteParticipant(ctx context.Context, dealID int, partici
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/delete_deals_id
 func (s *DealService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/deals/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -375,7 +612,7 @@ func (s *DealService) Delete(ctx context.Context, id int) (*Response, error) {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/delete_deals_id_products_product_attachment_id
 func (s *DealService) DeleteAttachedProduct(ctx context.Context, dealID int, productAttachmentID int) (*Response, error) {
   uri := fmt.Sprintf("/deals/%v/products/%v", dealID, productAttachmentID)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -383,3 +620,335 @@ func (s *DealService) DeleteAttachedProduct(ctx context.Context, dealID int, pro
 
   return s.client.Do(ctx, req, nil)
 }
+
+// DealParticipant represents a person participating in a deal.
+type DealParticipant struct {
+  ID            int      `json:"id"`
+  PersonID      PersonID `json:"person_id"`
+  Person        Person   `json:"person"`
+  AddedByUserID UserID   `json:"added_by_user_id"`
+  AddTime       string   `json:"add_time"`
+  ActiveFlag    bool     `json:"active_flag"`
+}
+
+func (p DealParticipant) String() string {
+  return Stringify(p)
+}
+
+// DealParticipantsResponse represents multiple deal participants response.
+type DealParticipantsResponse struct {
+  Success        bool              `json:"success"`
+  Data           []DealParticipant `json:"data"`
+  AdditionalData AdditionalData    `json:"additional_data"`
+}
+
+// DealParticipantResponse represents single deal participant response.
+type DealParticipantResponse struct {
+  Success bool            `json:"success"`
+  Data    DealParticipant `json:"data"`
+}
+
+// ListParticipants lists the participants of a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealParticipants
+func (s *DealService) ListParticipants(ctx context.Context, id int, opt *ListOptions) (*DealParticipantsResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/participants", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealParticipantsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllParticipants returns an iterator over all participants of a deal.
+func (s *DealService) ListAllParticipants(ctx context.Context, id int, opt *ListOptions) *Iterator[DealParticipant] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]DealParticipant, *AdditionalData, *Response, error) {
+    record, resp, err := s.ListParticipants(ctx, id, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// AddParticipant adds a person as participant of a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#addDealParticipant
+func (s *DealService) AddParticipant(ctx context.Context, id int, personID int) (*DealParticipantResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/participants", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, struct {
+    PersonID int `json:"person_id"`
+  }{
+    personID,
+  })
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealParticipantResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListFollowers lists the users following a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealFollowers
+func (s *DealService) ListFollowers(ctx context.Context, id int) (*FollowersResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/followers", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *FollowersResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// AddFollower adds a user as follower of a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#addDealFollower
+func (s *DealService) AddFollower(ctx context.Context, id int, userID int) (*FollowerResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/followers", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, struct {
+    UserID int `json:"user_id"`
+  }{
+    userID,
+  })
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *FollowerResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// DealActivitiesResponse represents the activities of a deal.
+type DealActivitiesResponse struct {
+  Success        bool           `json:"success"`
+  Data           []Activity     `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// DealActivitiesListOptions specifices the optional parameters to the
+// DealService.ListActivities method.
+type DealActivitiesListOptions struct {
+  // 0 for activities to do, 1 for done ones. All are returned when nil.
+  Done *uint8 `url:"done,omitempty"`
+
+  // Comma separated IDs of activities left out.
+  Exclude string `url:"exclude,omitempty"`
+
+  ListOptions
+}
+
+// ListActivities lists the activities of a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealActivities
+func (s *DealService) ListActivities(ctx context.Context, id int, opt *DealActivitiesListOptions) (*DealActivitiesResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/activities", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealActivitiesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllActivities returns an iterator over all activities of a deal.
+func (s *DealService) ListAllActivities(ctx context.Context, id int, opt *DealActivitiesListOptions) *Iterator[Activity] {
+  var filters DealActivitiesListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]Activity, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.ListActivities(ctx, id, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// DealFilesResponse represents the files attached to a deal.
+type DealFilesResponse struct {
+  Success        bool           `json:"success"`
+  Data           []File         `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// DealFilesListOptions specifices the optional parameters to the
+// DealService.ListFiles method.
+type DealFilesListOptions struct {
+  IncludeDeletedFiles uint8 `url:"include_deleted_files,omitempty"`
+
+  // Field names and sorting mode, e.g. "update_time DESC".
+  Sort string `url:"sort,omitempty"`
+
+  ListOptions
+}
+
+// ListFiles lists the files attached to a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealFiles
+func (s *DealService) ListFiles(ctx context.Context, id int, opt *DealFilesListOptions) (*DealFilesResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/files", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealFilesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllFiles returns an iterator over all files attached to a deal.
+func (s *DealService) ListAllFiles(ctx context.Context, id int, opt *DealFilesListOptions) *Iterator[File] {
+  var filters DealFilesListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]File, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.ListFiles(ctx, id, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// DealMailMessage represents a mail message linked to a deal.
+type DealMailMessage struct {
+  Object    string      `json:"object"`
+  Timestamp string      `json:"timestamp"`
+  Data      MailMessage `json:"data"`
+}
+
+// DealMailMessagesResponse represents the mail messages of a deal.
+type DealMailMessagesResponse struct {
+  Success        bool              `json:"success"`
+  Data           []DealMailMessage `json:"data"`
+  AdditionalData AdditionalData    `json:"additional_data"`
+}
+
+// ListMailMessages lists the mail messages linked to a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealMailMessages
+func (s *DealService) ListMailMessages(ctx context.Context, id int, opt *ListOptions) (*DealMailMessagesResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/mailMessages", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealMailMessagesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllMailMessages returns an iterator over all mail messages linked to a deal.
+func (s *DealService) ListAllMailMessages(ctx context.Context, id int, opt *ListOptions) *Iterator[DealMailMessage] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]DealMailMessage, *AdditionalData, *Response, error) {
+    record, resp, err := s.ListMailMessages(ctx, id, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// ListPermittedUsers lists the IDs of users allowed to access a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Deals#getDealUsers
+func (s *DealService) ListPermittedUsers(ctx context.Context, id int) (*PermittedUsersResponse, *Response, error) {
+  uri := fmt.Sprintf("/deals/%v/permittedUsers", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *PermittedUsersResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
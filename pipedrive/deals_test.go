@@ -0,0 +1,156 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "io/ioutil"
+  "net/http"
+  "testing"
+)
+
+func TestDealService_ListFields(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/deals:(id,title)", func(w http.ResponseWriter, r *http.Request) {
+    if got := r.URL.Query().Get("owned_by_you"); got != "1" {
+      t.Errorf("owned_by_you = %q, want 1", got)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":[{"id":1,"title":"Deal"}]}`)
+  })
+
+  record, _, err := client.Deals.List(context.Background(), &DealsListOptions{
+    OwnedByYou: 1,
+    Fields:     []string{"id", "title"},
+  })
+
+  if err != nil {
+    t.Fatalf("List returned error: %v", err)
+  }
+
+  if len(record.Data) != 1 || record.Data[0].Title != "Deal" {
+    t.Errorf("List returned %+v", record.Data)
+  }
+}
+
+func TestDealService_Summary(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/deals/summary", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":{"values_total":{"EUR":{"value":150.5,"count":2}},"total_count":2}}`)
+  })
+
+  record, _, err := client.Deals.Summary(context.Background(), &DealsSummaryOptions{Status: DealStatusWon})
+
+  if err != nil {
+    t.Fatalf("Summary returned error: %v", err)
+  }
+
+  if got := record.Data.ValuesTotal["EUR"]; got.Value != 150.5 || got.Count != 2 {
+    t.Errorf("Summary EUR total = %+v", got)
+  }
+}
+
+func TestDealService_ReconcileProducts(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  var added, updated, removed []string
+
+  mux.HandleFunc("/deals/1/products", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method == http.MethodPost {
+      body, _ := ioutil.ReadAll(r.Body)
+      added = append(added, string(body))
+      fmt.Fprint(w, `{"success":true,"data":{"id":30,"product_id":3}}`)
+      return
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":[
+      {"id":10,"product_id":1,"item_price":5,"quantity":2,"duration":1,"enabled_flag":true},
+      {"id":20,"product_id":2,"item_price":7,"quantity":1,"duration":1,"enabled_flag":true}
+    ],"additional_data":{"pagination":{"more_items_in_collection":false}}}`)
+  })
+
+  mux.HandleFunc("/deals/1/products/", func(w http.ResponseWriter, r *http.Request) {
+    switch r.Method {
+    case http.MethodPut:
+      updated = append(updated, r.URL.Path)
+      fmt.Fprint(w, `{"success":true,"data":{"id":20,"product_id":2,"item_price":8}}`)
+    case http.MethodDelete:
+      removed = append(removed, r.URL.Path)
+      fmt.Fprint(w, `{"success":true}`)
+    }
+  })
+
+  result, err := client.Deals.ReconcileProducts(context.Background(), 1, []DealProductAddOptions{
+    {ProductID: 2, ItemPrice: 8, Quantity: 1},
+    {ProductID: 3, ItemPrice: 1, Quantity: 4},
+  })
+
+  if err != nil {
+    t.Fatalf("ReconcileProducts returned error: %v", err)
+  }
+
+  if len(added) != 1 || len(updated) != 1 || updated[0] != "/deals/1/products/20" ||
+    len(removed) != 1 || removed[0] != "/deals/1/products/10" {
+    t.Errorf("added %v, updated %v, removed %v", added, updated, removed)
+  }
+
+  if len(result.Added) != 1 || len(result.Updated) != 1 || len(result.Removed) != 1 || len(result.Unchanged) != 0 {
+    t.Errorf("ReconcileProducts returned %+v", result)
+  }
+}
+
+func TestDealService_ReconcileProducts_emptyResponse(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/deals/1/products", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method == http.MethodGet {
+      fmt.Fprint(w, `{"success":true,"data":[]}`)
+    }
+  })
+
+  result, err := client.Deals.ReconcileProducts(context.Background(), 1, []DealProductAddOptions{{ProductID: 3, Quantity: 1}})
+
+  if err != nil || len(result.Added) != 0 {
+    t.Errorf("ReconcileProducts returned %+v, %v", result, err)
+  }
+}
+
+func TestDealService_ListUpdates(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/deals/1/flow", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":[
+      {"object":"dealChange","timestamp":"2021-01-02 10:00:00","data":{"id":5,"field_key":"value","old_value":"10","new_value":"20"}},
+      {"object":"note","timestamp":"2021-01-02 11:00:00","data":{"id":6,"content":"Called","add_time":"2021-01-02 11:00:00"}},
+      {"object":"mailMessage","timestamp":"2021-01-02 12:00:00","data":{"id":7,"subject":"Offer","read_flag":1}},
+      {"object":"invoice","timestamp":"2021-01-02 13:00:00","data":{"id":8}}
+    ]}`)
+  })
+
+  record, _, err := client.Deals.ListUpdates(context.Background(), 1, nil)
+
+  if err != nil {
+    t.Fatalf("ListUpdates returned error: %v", err)
+  }
+
+  if len(record.Data) != 4 {
+    t.Fatalf("ListUpdates returned %d items, want 4", len(record.Data))
+  }
+
+  if change, ok := record.Data[0].Data.(FieldChange); !ok || change.FieldKey != "value" || change.NewValue != "20" {
+    t.Errorf("item 0 = %#v, want a FieldChange", record.Data[0].Data)
+  }
+
+  if note, ok := record.Data[1].Data.(Note); !ok || note.Content != "Called" || note.AddTime.Hour() != 11 {
+    t.Errorf("item 1 = %#v, want a Note", record.Data[1].Data)
+  }
+
+  if message, ok := record.Data[2].Data.(MailMessage); !ok || message.Subject != "Offer" {
+    t.Errorf("item 2 = %#v, want a MailMessage", record.Data[2].Data)
+  }
+
+  if record.Data[3].Data != nil || len(record.Data[3].RawData) == 0 {
+    t.Errorf("item 3 = %#v, want raw data only", record.Data[3])
+  }
+}
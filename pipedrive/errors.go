@@ -1,16 +1,32 @@
 package pipedrive
 
 import (
+  "encoding/json"
+  "errors"
   "fmt"
   "net/http"
 )
 
-// RateLimitError occurs when Pipedrive returns 403 Forbidden response with a rate limit
-// remaining value of 0.
+// Maximum amount of the response body kept in a DecodeError.
+const decodeErrorSnippetSize = 512
+
+// Sentinel errors matched by the typed API errors with errors.Is.
+var (
+  ErrNotFound     = errors.New("pipedrive: not found")
+  ErrValidation   = errors.New("pipedrive: validation failed")
+  ErrUnauthorized = errors.New("pipedrive: unauthorized")
+  ErrForbidden    = errors.New("pipedrive: forbidden")
+  ErrRateLimited  = errors.New("pipedrive: rate limited")
+  ErrServer       = errors.New("pipedrive: server error")
+)
+
+// RateLimitError occurs when Pipedrive returns 429 Too Many Requests, or 403
+// Forbidden response with a rate limit remaining value of 0.
 type RateLimitError struct {
   Rate     Rate
   Response *http.Response
   Message  string
+  Body     []byte
 }
 
 func (e *RateLimitError) Error() string {
@@ -19,10 +35,24 @@ func (e *RateLimitError) Error() string {
     e.Response.StatusCode, e.Message)
 }
 
+// Is reports whether target is ErrRateLimited.
+func (e *RateLimitError) Is(target error) bool {
+  return target == ErrRateLimited
+}
+
 // ErrorResponse reports one or more errors caused by an API request.
 type ErrorResponse struct {
   Response *http.Response
   Message  string
+
+  // Error and ErrorInfo returned by Pipedrive.
+  ErrorFields
+
+  Data           json.RawMessage `json:"data,omitempty"`
+  AdditionalData json.RawMessage `json:"additional_data,omitempty"`
+
+  // Raw response body.
+  Body []byte `json:"-"`
 }
 
 func (e *ErrorResponse) Error() string {
@@ -30,3 +60,126 @@ func (e *ErrorResponse) Error() string {
     e.Response.Request.Method, e.Response.Request.URL,
     e.Response.StatusCode, e.Message)
 }
+
+// NotFoundError occurs when Pipedrive returns 404 Not Found.
+type NotFoundError struct {
+  *ErrorResponse
+}
+
+func (e *NotFoundError) Unwrap() error {
+  return e.ErrorResponse
+}
+
+// Is reports whether target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool {
+  return target == ErrNotFound
+}
+
+// ValidationError occurs when Pipedrive rejects the request parameters
+// with 400 Bad Request or 422 Unprocessable Entity.
+type ValidationError struct {
+  *ErrorResponse
+}
+
+func (e *ValidationError) Unwrap() error {
+  return e.ErrorResponse
+}
+
+// Is reports whether target is ErrValidation.
+func (e *ValidationError) Is(target error) bool {
+  return target == ErrValidation
+}
+
+// AuthError occurs when Pipedrive returns 401 Unauthorized.
+type AuthError struct {
+  *ErrorResponse
+}
+
+func (e *AuthError) Unwrap() error {
+  return e.ErrorResponse
+}
+
+// Is reports whether target is ErrUnauthorized.
+func (e *AuthError) Is(target error) bool {
+  return target == ErrUnauthorized
+}
+
+// PermissionError occurs when Pipedrive returns 403 Forbidden for a reason
+// other than the rate limit.
+type PermissionError struct {
+  *ErrorResponse
+}
+
+func (e *PermissionError) Unwrap() error {
+  return e.ErrorResponse
+}
+
+// Is reports whether target is ErrForbidden.
+func (e *PermissionError) Is(target error) bool {
+  return target == ErrForbidden
+}
+
+// ServerError occurs when Pipedrive returns a 5xx status code.
+type ServerError struct {
+  *ErrorResponse
+}
+
+func (e *ServerError) Unwrap() error {
+  return e.ErrorResponse
+}
+
+// Is reports whether target is ErrServer.
+func (e *ServerError) Is(target error) bool {
+  return target == ErrServer
+}
+
+// DecodeError occurs when a successful response body can not be decoded.
+type DecodeError struct {
+  Response *http.Response
+  Err      error
+
+  // Leading part of the response body.
+  Snippet []byte
+}
+
+func newDecodeError(r *http.Response, err error, body []byte) *DecodeError {
+  if len(body) > decodeErrorSnippetSize {
+    body = body[:decodeErrorSnippetSize]
+  }
+
+  return &DecodeError{
+    Response: r,
+    Err:      err,
+    Snippet:  body,
+  }
+}
+
+func (e *DecodeError) Error() string {
+  return fmt.Sprintf("%v %v: decoding response: %v: %q",
+    e.Response.Request.Method, e.Response.Request.URL,
+    e.Err, e.Snippet)
+}
+
+func (e *DecodeError) Unwrap() error {
+  return e.Err
+}
+
+// StreamError occurs when a successful response body can not be copied to
+// the io.Writer given to Client.Do.
+type StreamError struct {
+  Response *http.Response
+  Err      error
+
+  // Bytes of the body written before the failure.
+  Written int64
+}
+
+func (e *StreamError) Error() string {
+  return fmt.Sprintf("%v %v: copying response after %d bytes: %v",
+    e.Response.Request.Method, e.Response.Request.URL,
+    e.Written, e.Err)
+}
+
+func (e *StreamError) Unwrap() error {
+  return e.Err
+}
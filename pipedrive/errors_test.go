@@ -0,0 +1,84 @@
+package pipedrive
+
+import (
+  "context"
+  "errors"
+  "net/http"
+  "strings"
+  "testing"
+)
+
+func TestDo_typedErrors(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  tests := []struct {
+    status int
+    header string
+    target error
+  }{
+    {http.StatusNotFound, "", ErrNotFound},
+    {http.StatusBadRequest, "", ErrValidation},
+    {http.StatusUnauthorized, "", ErrUnauthorized},
+    {http.StatusForbidden, "", ErrForbidden},
+    {http.StatusForbidden, "0", ErrRateLimited},
+    {http.StatusTooManyRequests, "", ErrRateLimited},
+    {http.StatusBadGateway, "", ErrServer},
+  }
+
+  for _, tt := range tests {
+    tt := tt
+    path := "/errors/" + http.StatusText(tt.status) + tt.header
+
+    mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+      if tt.header != "" {
+        w.Header().Set(headerRateRemaining, tt.header)
+      }
+
+      w.WriteHeader(tt.status)
+      w.Write([]byte(`{"success":false,"error":"Boom","error_info":"Details","data":null}`))
+    })
+
+    req, _ := client.NewRequest(http.MethodGet, path, nil, nil)
+    _, err := client.Do(context.Background(), req, nil)
+
+    if !errors.Is(err, tt.target) {
+      t.Errorf("%v: Do returned %v, want %v", path, err, tt.target)
+    }
+
+    var errorResponse *ErrorResponse
+
+    if errors.As(err, &errorResponse) {
+      if errorResponse.Message != "Boom" || errorResponse.ErrorInfo != "Details" {
+        t.Errorf("%v: ErrorResponse = %+v", path, errorResponse)
+      }
+
+      if !strings.Contains(string(errorResponse.Body), "error_info") {
+        t.Errorf("%v: ErrorResponse.Body = %q", path, errorResponse.Body)
+      }
+    }
+  }
+}
+
+func TestDo_decodeError(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/deals/1", func(w http.ResponseWriter, r *http.Request) {
+    w.Write([]byte(`{"success":true,"data":{"id":"not-a-number"}}`))
+  })
+
+  req, _ := client.NewRequest(http.MethodGet, "/deals/1", nil, nil)
+
+  var record *DealResponse
+
+  _, err := client.Do(context.Background(), req, &record)
+
+  var decodeErr *DecodeError
+
+  if !errors.As(err, &decodeErr) {
+    t.Fatalf("Do returned %v, want *DecodeError", err)
+  }
+
+  if !strings.Contains(string(decodeErr.Snippet), "not-a-number") {
+    t.Errorf("DecodeError.Snippet = %q", decodeErr.Snippet)
+  }
+}
@@ -0,0 +1,224 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "errors"
+  "fmt"
+  "io"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "strings"
+)
+
+// Name of the manifest written by FilesService.ExportDealFiles.
+const FileExportManifestName = "manifest.json"
+
+// FileExportEntry describes a file mirrored by FilesService.ExportDealFiles.
+type FileExportEntry struct {
+  FileID int    `json:"file_id"`
+  DealID int    `json:"deal_id"`
+  Name   string `json:"name"`
+
+  // Path of the copy, relative to the export directory.
+  Path string `json:"path"`
+
+  Size       int64  `json:"size"`
+  UpdateTime string `json:"update_time"`
+
+  // Whether the copy is complete. Incomplete copies are resumed.
+  Complete bool `json:"complete"`
+}
+
+// FileExportManifest lists the files mirrored by
+// FilesService.ExportDealFiles. It is kept in the export directory.
+type FileExportManifest struct {
+  Files []FileExportEntry `json:"files"`
+}
+
+func (m *FileExportManifest) entry(fileID, dealID int) *FileExportEntry {
+  for i := range m.Files {
+    if m.Files[i].FileID == fileID && m.Files[i].DealID == dealID {
+      return &m.Files[i]
+    }
+  }
+
+  m.Files = append(m.Files, FileExportEntry{FileID: fileID, DealID: dealID})
+
+  return &m.Files[len(m.Files)-1]
+}
+
+// ExportDealFiles mirrors the files attached to deals into dir, laid out
+// as deal-<deal ID>/<file ID>-<file name>, and lists them in a manifest.
+// Files copied completely by a previous export are skipped unless they
+// changed since, and partial copies are resumed. The manifest is saved
+// after every file, so an interrupted export can be run again to finish.
+// Files stored outside Pipedrive, e.g. on Google Drive, are left out.
+func (s *FilesService) ExportDealFiles(ctx context.Context, dir string, dealIDs ...int) (*FileExportManifest, error) {
+  if err := os.MkdirAll(dir, 0755); err != nil {
+    return nil, err
+  }
+
+  manifest, err := readFileExportManifest(dir)
+
+  if err != nil {
+    return nil, err
+  }
+
+  for _, dealID := range dealIDs {
+    files, err := s.client.Deals.ListAllFiles(ctx, dealID, nil).All()
+
+    if err != nil {
+      return manifest, fmt.Errorf("listing files of deal %v: %w", dealID, err)
+    }
+
+    for _, file := range files {
+      if file.RemoteLocation != "" && file.RemoteLocation != "s3" {
+        continue
+      }
+
+      entry := manifest.entry(file.ID, dealID)
+      err := s.exportFile(ctx, dir, file, entry)
+
+      if saveErr := writeFileExportManifest(dir, manifest); err == nil {
+        err = saveErr
+      }
+
+      if err != nil {
+        return manifest, fmt.Errorf("exporting file %v of deal %v: %w", file.ID, dealID, err)
+      }
+    }
+  }
+
+  return manifest, nil
+}
+
+func (s *FilesService) exportFile(ctx context.Context, dir string, file File, entry *FileExportEntry) error {
+  changed := entry.UpdateTime != file.UpdateTime || entry.Size != int64(file.FileSize)
+
+  entry.Name = file.Name
+  entry.Path = filepath.Join(fmt.Sprintf("deal-%d", entry.DealID), exportFileName(file))
+  entry.Size = int64(file.FileSize)
+  entry.UpdateTime = file.UpdateTime
+
+  path := filepath.Join(dir, entry.Path)
+
+  if info, err := os.Stat(path); err == nil && entry.Complete && !changed && info.Size() == entry.Size {
+    return nil
+  }
+
+  entry.Complete = false
+
+  if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+    return err
+  }
+
+  f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0644)
+
+  if err != nil {
+    return err
+  }
+
+  defer f.Close()
+
+  info, err := f.Stat()
+
+  if err != nil {
+    return err
+  }
+
+  offset := info.Size()
+
+  if changed || offset > entry.Size {
+    offset = 0
+  }
+
+  if err := f.Truncate(offset); err != nil {
+    return err
+  }
+
+  if _, err := f.Seek(offset, io.SeekStart); err != nil {
+    return err
+  }
+
+  if _, err := s.DownloadFrom(ctx, file.ID, f, offset); err != nil {
+    return err
+  }
+
+  if err := f.Close(); err != nil {
+    return err
+  }
+
+  entry.Complete = true
+
+  return nil
+}
+
+// exportFileName names the copy of a file after its ID and a name safe to
+// use as a file name.
+func exportFileName(file File) string {
+  name := file.FileName
+
+  if name == "" {
+    name = file.Name
+  }
+
+  name = strings.Map(func(r rune) rune {
+    if r == '/' || r == '\\' || r < ' ' {
+      return '_'
+    }
+
+    return r
+  }, name)
+
+  return fmt.Sprintf("%d-%s", file.ID, strings.TrimLeft(name, "."))
+}
+
+func readFileExportManifest(dir string) (*FileExportManifest, error) {
+  manifest := &FileExportManifest{}
+  data, err := ioutil.ReadFile(filepath.Join(dir, FileExportManifestName))
+
+  if errors.Is(err, os.ErrNotExist) {
+    return manifest, nil
+  }
+
+  if err != nil {
+    return nil, err
+  }
+
+  if err := json.Unmarshal(data, manifest); err != nil {
+    return nil, fmt.Errorf("reading export manifest: %w", err)
+  }
+
+  return manifest, nil
+}
+
+// writeFileExportManifest replaces the manifest through a rename, so an
+// interrupted write never leaves a truncated manifest behind.
+func writeFileExportManifest(dir string, manifest *FileExportManifest) error {
+  data, err := json.MarshalIndent(manifest, "", "  ")
+
+  if err != nil {
+    return err
+  }
+
+  tmp, err := ioutil.TempFile(dir, FileExportManifestName+".*.tmp")
+
+  if err != nil {
+    return err
+  }
+
+  defer os.Remove(tmp.Name())
+
+  if _, err := tmp.Write(data); err != nil {
+    tmp.Close()
+    return err
+  }
+
+  if err := tmp.Close(); err != nil {
+    return err
+  }
+
+  return os.Rename(tmp.Name(), filepath.Join(dir, FileExportManifestName))
+}
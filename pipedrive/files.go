@@ -1,13 +1,17 @@
 package pipedrive
 
 import (
-  "bytes"
   "context"
+  "errors"
   "fmt"
-  "io/ioutil"
-  "mime/multipart"
+  "io"
   "net/http"
+  "net/url"
   "os"
+  "path/filepath"
+  "strconv"
+  "strings"
+  "time"
 )
 
 // FilesService handles files related
@@ -71,7 +75,7 @@ type FilesResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/get_files
 func (s *FilesService) List(ctx context.Context) (*FilesResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/files", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/files", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -88,12 +92,33 @@ func (s *FilesService) List(ctx context.Context) (*FilesResponse, *Response, err
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all files.
+func (s *FilesService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[File] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]File, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/files", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *FilesResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // GetByID returns specific file.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/get_files_id
 func (s *FilesService) GetByID(ctx context.Context, id int) (*FileResponse, *Response, error) {
   uri := fmt.Sprintf("/files/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -110,12 +135,14 @@ func (s *FilesService) GetByID(ctx context.Context, id int) (*FileResponse, *Res
   return record, resp, nil
 }
 
-// GetDownloadLinkByID returns link for specific file.
+// GetDownloadLinkByID returns link for specific file and the request,
+// bound to ctx, that downloads it. The link carries no credentials; use
+// Download to fetch the file.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/get_files_id_download
-func (s *FilesService) GetDownloadLinkByID(id int) (string, *http.Request, error) {
+func (s *FilesService) GetDownloadLinkByID(ctx context.Context, id int) (string, *http.Request, error) {
   uri := fmt.Sprintf("/files/%v/download", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return "", nil, err
@@ -124,47 +151,226 @@ func (s *FilesService) GetDownloadLinkByID(id int) (string, *http.Request, error
   return string(req.URL.Scheme + "://" + req.URL.Host + req.URL.Path), req, nil
 }
 
-// Upload a file.
+// ErrDownloadSize is returned when a downloaded file is not as large as
+// Pipedrive reports it to be.
+var ErrDownloadSize = errors.New("pipedrive: downloaded size does not match file size")
+
+// Download streams the contents of a file to w. Transfers cut short are
+// resumed with Range requests, and the bytes received are checked against
+// the size of the file when it is known, from the file metadata or else
+// from the Content-Range and Content-Length headers of the download.
 //
-// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files
-func (s *FilesService) Upload(ctx context.Context, fileName string, filePath string) (*FileResponse, *Response, error) {
-  file, err := os.Open(filePath)
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/get_files_id_download
+func (s *FilesService) Download(ctx context.Context, id int, w io.Writer) (*Response, error) {
+  return s.DownloadFrom(ctx, id, w, 0)
+}
+
+// DownloadFrom streams the contents of a file from offset on to w, e.g. to
+// complete a partial copy holding offset bytes.
+func (s *FilesService) DownloadFrom(ctx context.Context, id int, w io.Writer, offset int64) (*Response, error) {
+  record, resp, err := s.GetByID(ctx, id)
 
   if err != nil {
-    return nil, nil, err
+    return resp, err
   }
 
-  defer file.Close()
+  var size int64
 
-  fileContents, err := ioutil.ReadAll(file)
+  if record != nil {
+    size = int64(record.Data.FileSize)
+  }
 
-  if err != nil {
-    return nil, nil, err
+  if size > 0 && offset > size {
+    return resp, fmt.Errorf("%w: file %v has %d bytes, not %d", ErrDownloadSize, id, size, offset)
   }
 
-  fileInfo, err := file.Stat()
+  policy := s.client.retryPolicy
+  written := offset
 
-  if err != nil {
-    return nil, nil, err
+  for failures := 0; size <= 0 || written < size; {
+    dst := &rangeWriter{writer: w, offset: written}
+
+    resp, err = s.download(ctx, id, dst)
+    written += dst.written
+
+    if size <= 0 {
+      size = dst.size
+    }
+
+    if err == nil {
+      break
+    }
+
+    var streamErr *StreamError
+
+    if !errors.As(err, &streamErr) || !IsRetryableError(streamErr.Err) {
+      return resp, err
+    }
+
+    // Transfers that made progress are resumed right away, others are
+    // retried according to the client retry policy.
+    if dst.written > 0 {
+      failures = 0
+      continue
+    }
+
+    if failures++; failures >= policy.maxAttempts() {
+      return resp, err
+    }
+
+    timer := time.NewTimer(policy.delay(failures, resp, err))
+
+    select {
+    case <-ctx.Done():
+      timer.Stop()
+      return resp, ctx.Err()
+    case <-timer.C:
+    }
+  }
+
+  if size > 0 && written != size {
+    return resp, fmt.Errorf("%w: got %d bytes of file %v, want %d", ErrDownloadSize, written, id, size)
   }
 
-  var body *bytes.Buffer
-  writer := multipart.NewWriter(body)
-  part, err := writer.CreateFormFile(fileName, fileInfo.Name())
+  return resp, nil
+}
+
+func (s *FilesService) download(ctx context.Context, id int, dst *rangeWriter) (*Response, error) {
+  uri := fmt.Sprintf("/files/%v/download", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
-    return nil, nil, err
+    return nil, err
   }
 
-  part.Write(fileContents)
+  if dst.offset > 0 {
+    req.Header.Set("Range", fmt.Sprintf("bytes=%d-", dst.offset))
+  }
 
-  err = writer.Close()
+  return s.client.Do(ctx, req, dst)
+}
 
-  if err != nil {
-    return nil, nil, err
+// rangeWriter writes the contents of a file from offset on. The leading
+// bytes are skipped when the server ignores the Range header. The size of
+// the file is read from the response headers, 0 when unknown.
+type rangeWriter struct {
+  writer  io.Writer
+  offset  int64
+  skip    int64
+  written int64
+  size    int64
+}
+
+func (w *rangeWriter) startResponse(resp *http.Response) error {
+  if resp.StatusCode != http.StatusPartialContent {
+    w.skip = w.offset
+
+    if resp.ContentLength > 0 {
+      w.size = resp.ContentLength
+    }
+
+    return nil
+  }
+
+  // Content-Range: bytes 5-10/11, where the size may be "*".
+  contentRange := resp.Header.Get("Content-Range")
+
+  if i := strings.LastIndexByte(contentRange, '/'); i >= 0 {
+    if size, err := strconv.ParseInt(contentRange[i+1:], 10, 64); err == nil {
+      w.size = size
+    }
+  } else if resp.ContentLength > 0 {
+    w.size = w.offset + resp.ContentLength
   }
 
-  req, err := s.client.NewRequest(http.MethodPost, "/files", nil, body)
+  return nil
+}
+
+func (w *rangeWriter) Write(p []byte) (int, error) {
+  skipped := 0
+
+  if w.skip > 0 {
+    skipped = len(p)
+
+    if int64(skipped) > w.skip {
+      skipped = int(w.skip)
+    }
+
+    w.skip -= int64(skipped)
+    p = p[skipped:]
+  }
+
+  n, err := w.writer.Write(p)
+  w.written += int64(n)
+
+  return skipped + n, err
+}
+
+// FileUploadOptions specifices the optional parameters to the
+// FilesService.Upload method. The file is attached to the given items.
+type FileUploadOptions struct {
+  DealID     int
+  PersonID   int
+  OrgID      int
+  ProductID  int
+  ActivityID int
+  NoteID     int
+  LeadID     string
+
+  // Content type of the file. Detected from the file name when empty.
+  ContentType string
+
+  // Size of the file in bytes, passed to Progress. Zero when unknown.
+  Size int64
+
+  // Progress, when set, is called while the file is sent with the number
+  // of bytes read so far and Size.
+  Progress func(sent, size int64)
+}
+
+func (o *FileUploadOptions) fields() url.Values {
+  fields := url.Values{}
+
+  for key, id := range map[string]int{
+    "deal_id":     o.DealID,
+    "person_id":   o.PersonID,
+    "org_id":      o.OrgID,
+    "product_id":  o.ProductID,
+    "activity_id": o.ActivityID,
+    "note_id":     o.NoteID,
+  } {
+    if id != 0 {
+      fields.Set(key, strconv.Itoa(id))
+    }
+  }
+
+  if o.LeadID != "" {
+    fields.Set("lead_id", o.LeadID)
+  }
+
+  return fields
+}
+
+// Upload a file read from r. The file is streamed while the request is
+// sent, so it is never held in memory as a whole, and the request is not
+// retried.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files
+func (s *FilesService) Upload(ctx context.Context, fileName string, r io.Reader, opt *FileUploadOptions) (*FileResponse, *Response, error) {
+  if opt == nil {
+    opt = &FileUploadOptions{}
+  }
+
+  if opt.Progress != nil {
+    r = &progressReader{reader: r, size: opt.Size, progress: opt.Progress}
+  }
+
+  req, err := s.client.NewMultipartRequestWithContext(ctx, http.MethodPost, "/files", nil, opt.fields(), &MultipartFile{
+    FieldName:   "file",
+    FileName:    fileName,
+    ContentType: opt.ContentType,
+    Reader:      r,
+  })
 
   if err != nil {
     return nil, nil, err
@@ -181,6 +387,55 @@ func (s *FilesService) Upload(ctx context.Context, fileName string, filePath str
   return record, resp, nil
 }
 
+// UploadFile uploads the file at filePath, named after its base name. Size
+// is set from the file when opt leaves it zero.
+func (s *FilesService) UploadFile(ctx context.Context, filePath string, opt *FileUploadOptions) (*FileResponse, *Response, error) {
+  file, err := os.Open(filePath)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  defer file.Close()
+
+  upload := FileUploadOptions{}
+
+  if opt != nil {
+    upload = *opt
+  }
+
+  if upload.Size == 0 {
+    info, err := file.Stat()
+
+    if err != nil {
+      return nil, nil, err
+    }
+
+    upload.Size = info.Size()
+  }
+
+  return s.Upload(ctx, filepath.Base(filePath), file, &upload)
+}
+
+// progressReader reports the bytes read from reader.
+type progressReader struct {
+  reader   io.Reader
+  size     int64
+  sent     int64
+  progress func(sent, size int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+  n, err := r.reader.Read(p)
+
+  if n > 0 {
+    r.sent += int64(n)
+    r.progress(r.sent, r.size)
+  }
+
+  return n, err
+}
+
 // CreateRemoteLinkedFileOptions specifices the optional parameters to the
 // FilesService.CreateRemoteLinkedFile method.
 type CreateRemoteLinkedFileOptions struct {
@@ -195,7 +450,7 @@ type CreateRemoteLinkedFileOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files_remote
 func (s *FilesService) CreateRemoteLinkedFile(ctx context.Context, opt *CreateRemoteLinkedFileOptions) (*FileResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/files/remote", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/files/remote", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -225,7 +480,7 @@ type LinkRemoteFileToItemOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files_remoteLink
 func (s *FilesService) LinkRemoteFileToItem(ctx context.Context, opt *LinkRemoteFileToItemOptions) (*FileResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/files/remoteLink", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/files/remoteLink", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -254,7 +509,7 @@ type UpdateFileDetailsOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/put_files_id
 func (s *FilesService) Update(ctx context.Context, id int, opt *UpdateFileDetailsOptions) (*FileResponse, *Response, error) {
   uri := fmt.Sprintf("/files/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -276,7 +531,7 @@ func (s *FilesService) Update(ctx context.Context, id int, opt *UpdateFileDetail
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/delete_files_id
 func (s *FilesService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/files/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
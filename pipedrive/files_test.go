@@ -0,0 +1,204 @@
+package pipedrive
+
+import (
+  "bytes"
+  "context"
+  "errors"
+  "fmt"
+  "io/ioutil"
+  "net/http"
+  "os"
+  "path/filepath"
+  "strings"
+  "testing"
+)
+
+func TestFilesService_Upload(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/files", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodPost {
+      t.Errorf("method = %v, want POST", r.Method)
+    }
+
+    if err := r.ParseMultipartForm(1 << 20); err != nil {
+      t.Fatalf("ParseMultipartForm returned error: %v", err)
+    }
+
+    if got := r.FormValue("deal_id"); got != "7" {
+      t.Errorf("deal_id = %q, want 7", got)
+    }
+
+    if got := r.FormValue("lead_id"); got != "" {
+      t.Errorf("lead_id = %q, want none", got)
+    }
+
+    file, header, err := r.FormFile("file")
+
+    if err != nil {
+      t.Fatalf("FormFile returned error: %v", err)
+    }
+
+    contents, _ := ioutil.ReadAll(file)
+
+    if header.Filename != "notes.txt" || string(contents) != "hello world" || !strings.HasPrefix(header.Header.Get("Content-Type"), "text/plain") {
+      t.Errorf("file = %v %q %q", header.Filename, contents, header.Header.Get("Content-Type"))
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":{"id":1,"deal_id":7,"file_name":"notes.txt"}}`)
+  })
+
+  var sent, size int64
+
+  record, _, err := client.Files.Upload(context.Background(), "notes.txt", strings.NewReader("hello world"), &FileUploadOptions{
+    DealID: 7,
+    Size:   11,
+    Progress: func(n, total int64) {
+      sent, size = n, total
+    },
+  })
+
+  if err != nil {
+    t.Fatalf("Upload returned error: %v", err)
+  }
+
+  if record.Data.DealID != 7 {
+    t.Errorf("Upload returned %+v", record.Data)
+  }
+
+  if sent != 11 || size != 11 {
+    t.Errorf("Progress reported %d of %d bytes, want 11 of 11", sent, size)
+  }
+}
+
+func TestFilesService_Download_resume(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/files/3", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":{"id":3,"file_size":11}}`)
+  })
+
+  mux.HandleFunc("/files/3/download", func(w http.ResponseWriter, r *http.Request) {
+    if r.URL.Query().Get("api_token") != "test-token" {
+      t.Errorf("download is not authenticated: %v", r.URL)
+    }
+
+    switch rng := r.Header.Get("Range"); rng {
+    case "":
+      // Cut the transfer short after 5 bytes.
+      w.Header().Set("Content-Length", "11")
+      w.Write([]byte("hello"))
+      w.(http.Flusher).Flush()
+
+      conn, _, _ := w.(http.Hijacker).Hijack()
+      conn.Close()
+    case "bytes=5-":
+      w.WriteHeader(http.StatusPartialContent)
+      fmt.Fprint(w, " world")
+    default:
+      t.Errorf("Unexpected range %q", rng)
+    }
+  })
+
+  var buf bytes.Buffer
+
+  if _, err := client.Files.Download(context.Background(), 3, &buf); err != nil {
+    t.Fatalf("Download returned error: %v", err)
+  }
+
+  if buf.String() != "hello world" {
+    t.Errorf("Download wrote %q, want %q", buf.String(), "hello world")
+  }
+}
+
+func TestFilesService_Download_unknownSize(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/files/3", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":{"id":3,"file_size":0}}`)
+  })
+
+  mux.HandleFunc("/files/3/download", func(w http.ResponseWriter, r *http.Request) {
+    if r.Header.Get("Range") != "bytes=6-" {
+      t.Errorf("Range = %q, want bytes=6-", r.Header.Get("Range"))
+    }
+
+    w.Header().Set("Content-Range", "bytes 6-10/11")
+    w.WriteHeader(http.StatusPartialContent)
+    fmt.Fprint(w, "world")
+  })
+
+  var buf bytes.Buffer
+
+  if _, err := client.Files.DownloadFrom(context.Background(), 3, &buf, 6); err != nil {
+    t.Fatalf("DownloadFrom returned error: %v", err)
+  }
+
+  if buf.String() != "world" {
+    t.Errorf("DownloadFrom wrote %q, want %q", buf.String(), "world")
+  }
+}
+
+func TestFilesService_Download_sizeMismatch(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/files/3", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":{"id":3,"file_size":11}}`)
+  })
+
+  mux.HandleFunc("/files/3/download", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, "hello")
+  })
+
+  if _, err := client.Files.Download(context.Background(), 3, ioutil.Discard); !errors.Is(err, ErrDownloadSize) {
+    t.Errorf("Download returned %v, want ErrDownloadSize", err)
+  }
+}
+
+func TestFilesService_ExportDealFiles(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  var downloads int
+
+  mux.HandleFunc("/deals/7/files", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":[
+      {"id":3,"deal_id":7,"file_name":"quote.pdf","file_size":5,"update_time":"2024-01-02 10:00:00","remote_location":"s3"},
+      {"id":4,"deal_id":7,"file_name":"Drive doc","remote_location":"googledocs"}
+    ]}`)
+  })
+
+  mux.HandleFunc("/files/3", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":{"id":3,"file_size":5}}`)
+  })
+
+  mux.HandleFunc("/files/3/download", func(w http.ResponseWriter, r *http.Request) {
+    downloads++
+    fmt.Fprint(w, "%PDF-")
+  })
+
+  dir := t.TempDir()
+
+  for run := 0; run < 2; run++ {
+    manifest, err := client.Files.ExportDealFiles(context.Background(), dir, 7)
+
+    if err != nil {
+      t.Fatalf("ExportDealFiles returned error: %v", err)
+    }
+
+    if len(manifest.Files) != 1 || !manifest.Files[0].Complete || manifest.Files[0].Path != filepath.Join("deal-7", "3-quote.pdf") {
+      t.Errorf("ExportDealFiles returned %+v", manifest.Files)
+    }
+  }
+
+  if downloads != 1 {
+    t.Errorf("ExportDealFiles downloaded %d times, want 1", downloads)
+  }
+
+  if data, _ := ioutil.ReadFile(filepath.Join(dir, "deal-7", "3-quote.pdf")); string(data) != "%PDF-" {
+    t.Errorf("exported file holds %q", data)
+  }
+
+  if _, err := os.Stat(filepath.Join(dir, FileExportManifestName)); err != nil {
+    t.Errorf("manifest not written: %v", err)
+  }
+}
@@ -0,0 +1,559 @@
+package pipedrive
+
+import (
+  "context"
+  "errors"
+  "fmt"
+  "strconv"
+  "time"
+)
+
+// Maximum number of conditions in a group of filter conditions.
+const filterGroupMaxConditions = 16
+
+// ErrFilterCondition is returned for filter conditions Pipedrive would not
+// accept.
+var ErrFilterCondition = errors.New("pipedrive: invalid filter condition")
+
+// FilterGlue joins filter conditions.
+type FilterGlue string
+
+const (
+  FilterGlueAnd FilterGlue = "and"
+  FilterGlueOr  FilterGlue = "or"
+)
+
+// FilterObject is the type of item a filter condition applies to.
+type FilterObject string
+
+const (
+  FilterObjectDeal         FilterObject = "deal"
+  FilterObjectPerson       FilterObject = "person"
+  FilterObjectOrganization FilterObject = "organization"
+  FilterObjectProduct      FilterObject = "product"
+  FilterObjectActivity     FilterObject = "activity"
+)
+
+// FilterOperator compares a field with the value of a filter condition.
+type FilterOperator string
+
+const (
+  FilterOperatorEqual          FilterOperator = "="
+  FilterOperatorNotEqual       FilterOperator = "!="
+  FilterOperatorLessThan       FilterOperator = "<"
+  FilterOperatorGreaterThan    FilterOperator = ">"
+  FilterOperatorLessOrEqual    FilterOperator = "<="
+  FilterOperatorGreaterOrEqual FilterOperator = ">="
+  FilterOperatorIsNull         FilterOperator = "IS NULL"
+  FilterOperatorIsNotNull      FilterOperator = "IS NOT NULL"
+  FilterOperatorStartsWith     FilterOperator = "LIKE '$%'"
+  FilterOperatorContains       FilterOperator = "LIKE '%$%'"
+  FilterOperatorNotStartsWith  FilterOperator = "NOT LIKE '$%'"
+)
+
+var (
+  filterOptionOperators = []FilterOperator{
+    FilterOperatorEqual, FilterOperatorNotEqual,
+    FilterOperatorIsNull, FilterOperatorIsNotNull,
+  }
+
+  filterTextOperators = append(filterOptionOperators,
+    FilterOperatorStartsWith, FilterOperatorContains, FilterOperatorNotStartsWith)
+
+  filterNumberOperators = append(filterOptionOperators,
+    FilterOperatorLessThan, FilterOperatorGreaterThan,
+    FilterOperatorLessOrEqual, FilterOperatorGreaterOrEqual)
+)
+
+// Operators accepted by field type. Operators on fields of other types are
+// not validated.
+var filterOperatorsByType = map[FieldType][]FilterOperator{
+  FieldTypeVarchar:     filterTextOperators,
+  FieldTypeVarcharAuto: filterTextOperators,
+  FieldTypeText:        filterTextOperators,
+  FieldTypePhone:       filterTextOperators,
+  FieldTypeAddress:     filterTextOperators,
+  FieldTypeDouble:      filterNumberOperators,
+  FieldTypeMonetary:    filterNumberOperators,
+  FieldTypeInt:         filterNumberOperators,
+  FieldTypeDate:        filterNumberOperators,
+  FieldTypeTime:        filterNumberOperators,
+  FieldTypeDaterange:   filterNumberOperators,
+  FieldTypeTimerange:   filterNumberOperators,
+  FieldTypeEnum:        filterOptionOperators,
+  FieldTypeSet:         filterOptionOperators,
+  FieldTypeUser:        filterOptionOperators,
+  FieldTypeOrg:         filterOptionOperators,
+  FieldTypePeople:      filterOptionOperators,
+  FieldTypeStage:       filterOptionOperators,
+  FieldTypeStatus:      filterOptionOperators,
+  FieldTypeVisibleTo:   filterOptionOperators,
+}
+
+// FilterExpression is a filter condition or a group of them, see FilterAnd
+// and FilterOr.
+type FilterExpression interface {
+  filterExpression()
+}
+
+// FilterField is a field filter conditions are built on, named by its name
+// or key.
+//
+//   pipedrive.FilterAnd(
+//     pipedrive.FilterDeal("value").GreaterThan(1000),
+//     pipedrive.FilterPerson("email").Contains("@acme"),
+//   )
+type FilterField struct {
+  Object FilterObject
+  Name   string
+}
+
+// FilterDeal returns the deal field with the given name or key.
+func FilterDeal(name string) FilterField {
+  return FilterField{FilterObjectDeal, name}
+}
+
+// FilterPerson returns the person field with the given name or key.
+func FilterPerson(name string) FilterField {
+  return FilterField{FilterObjectPerson, name}
+}
+
+// FilterOrganization returns the organization field with the given name or
+// key.
+func FilterOrganization(name string) FilterField {
+  return FilterField{FilterObjectOrganization, name}
+}
+
+// FilterProduct returns the product field with the given name or key.
+func FilterProduct(name string) FilterField {
+  return FilterField{FilterObjectProduct, name}
+}
+
+// FilterActivity returns the activity field with the given name or key.
+func FilterActivity(name string) FilterField {
+  return FilterField{FilterObjectActivity, name}
+}
+
+// Condition returns a condition comparing the field with value.
+func (f FilterField) Condition(operator FilterOperator, value interface{}) FilterCondition {
+  return FilterCondition{FilterField: f, Operator: operator, Value: value}
+}
+
+// Equal matches items whose field equals value.
+func (f FilterField) Equal(value interface{}) FilterCondition {
+  return f.Condition(FilterOperatorEqual, value)
+}
+
+// NotEqual matches items whose field differs from value.
+func (f FilterField) NotEqual(value interface{}) FilterCondition {
+  return f.Condition(FilterOperatorNotEqual, value)
+}
+
+// LessThan matches items whose field is less than value.
+func (f FilterField) LessThan(value interface{}) FilterCondition {
+  return f.Condition(FilterOperatorLessThan, value)
+}
+
+// GreaterThan matches items whose field is greater than value.
+func (f FilterField) GreaterThan(value interface{}) FilterCondition {
+  return f.Condition(FilterOperatorGreaterThan, value)
+}
+
+// LessOrEqual matches items whose field is at most value.
+func (f FilterField) LessOrEqual(value interface{}) FilterCondition {
+  return f.Condition(FilterOperatorLessOrEqual, value)
+}
+
+// GreaterOrEqual matches items whose field is at least value.
+func (f FilterField) GreaterOrEqual(value interface{}) FilterCondition {
+  return f.Condition(FilterOperatorGreaterOrEqual, value)
+}
+
+// IsEmpty matches items without a value in the field.
+func (f FilterField) IsEmpty() FilterCondition {
+  return f.Condition(FilterOperatorIsNull, nil)
+}
+
+// IsNotEmpty matches items with a value in the field.
+func (f FilterField) IsNotEmpty() FilterCondition {
+  return f.Condition(FilterOperatorIsNotNull, nil)
+}
+
+// StartsWith matches items whose field starts with value.
+func (f FilterField) StartsWith(value string) FilterCondition {
+  return f.Condition(FilterOperatorStartsWith, value)
+}
+
+// Contains matches items whose field contains value.
+func (f FilterField) Contains(value string) FilterCondition {
+  return f.Condition(FilterOperatorContains, value)
+}
+
+// NotStartsWith matches items whose field does not start with value.
+func (f FilterField) NotStartsWith(value string) FilterCondition {
+  return f.Condition(FilterOperatorNotStartsWith, value)
+}
+
+// FilterCondition compares a field with a value. Options of enum and set
+// fields are given by label or ID, and dates as time.Time or strings.
+// ExtraValue holds the relative period of date conditions, e.g. "today".
+type FilterCondition struct {
+  FilterField
+
+  Operator   FilterOperator
+  Value      interface{}
+  ExtraValue interface{}
+}
+
+// FilterGroup joins filter conditions.
+type FilterGroup struct {
+  Glue        FilterGlue
+  Expressions []FilterExpression
+}
+
+func (FilterCondition) filterExpression() {}
+func (FilterGroup) filterExpression()     {}
+
+// FilterAnd matches items matching all expressions. Pipedrive supports
+// conditions and a single FilterOr of conditions in it.
+func FilterAnd(expressions ...FilterExpression) FilterGroup {
+  return FilterGroup{FilterGlueAnd, expressions}
+}
+
+// FilterOr matches items matching any of the conditions.
+func FilterOr(expressions ...FilterExpression) FilterGroup {
+  return FilterGroup{FilterGlueOr, expressions}
+}
+
+// Build converts a filter expression to the conditions of
+// FilterCreateOptions and FilterUpdateOptions. Fields are looked up
+// through the field services, and operators and values are validated
+// against their type.
+func (s *FiltersService) Build(ctx context.Context, expr FilterExpression) (*FilterConditions, error) {
+  and, or, err := splitFilterExpression(expr)
+
+  if err != nil {
+    return nil, err
+  }
+
+  fields := s.fields(ctx)
+  conditions := &FilterConditions{
+    Glue: FilterGlueAnd,
+    Conditions: []FilterConditionGroup{
+      {Glue: FilterGlueAnd, Conditions: []FilterConditionEntry{}},
+      {Glue: FilterGlueOr, Conditions: []FilterConditionEntry{}},
+    },
+  }
+
+  for i, group := range [][]FilterCondition{and, or} {
+    for _, condition := range group {
+      entry, err := condition.entry(fields)
+
+      if err != nil {
+        return nil, err
+      }
+
+      conditions.Conditions[i].Conditions = append(conditions.Conditions[i].Conditions, entry)
+    }
+  }
+
+  return conditions, nil
+}
+
+// Parse converts filter conditions, e.g. of a filter returned by GetByID,
+// to a filter expression naming fields by key. Values are kept as
+// returned by Pipedrive, and each group is joined by its own glue.
+func (s *FiltersService) Parse(ctx context.Context, conditions *FilterConditions) (FilterExpression, error) {
+  if conditions == nil {
+    return nil, fmt.Errorf("%w: no conditions", ErrFilterCondition)
+  }
+
+  glue := conditions.Glue
+
+  if glue == "" {
+    glue = FilterGlueAnd
+  }
+
+  if !glue.valid() {
+    return nil, fmt.Errorf("%w: glue %q", ErrFilterCondition, glue)
+  }
+
+  fields := s.fields(ctx)
+  var expressions []FilterExpression
+
+  for _, group := range conditions.Conditions {
+    if !group.Glue.valid() {
+      return nil, fmt.Errorf("%w: glue %q", ErrFilterCondition, group.Glue)
+    }
+
+    var groupExpressions []FilterExpression
+
+    for _, entry := range group.Conditions {
+      resolver, err := fields(entry.Object)
+
+      if err != nil {
+        return nil, err
+      }
+
+      id, err := strconv.Atoi(entry.FieldID)
+
+      if err != nil {
+        return nil, fmt.Errorf("%w: field ID %q", ErrFilterCondition, entry.FieldID)
+      }
+
+      field, ok := resolver.fieldByID(id)
+
+      if !ok {
+        return nil, fmt.Errorf("%w %v field %v", ErrUnknownField, entry.Object, id)
+      }
+
+      groupExpressions = append(groupExpressions, FilterCondition{
+        FilterField: FilterField{entry.Object, field.Key},
+        Operator:    entry.Operator,
+        Value:       entry.Value,
+        ExtraValue:  entry.ExtraValue,
+      })
+    }
+
+    switch {
+    case len(groupExpressions) == 0:
+    case group.Glue == glue:
+      expressions = append(expressions, groupExpressions...)
+    default:
+      expressions = append(expressions, FilterGroup{group.Glue, groupExpressions})
+    }
+  }
+
+  if len(expressions) == 1 {
+    if group, ok := expressions[0].(FilterGroup); ok {
+      return group, nil
+    }
+  }
+
+  return FilterGroup{glue, expressions}, nil
+}
+
+func (g FilterGlue) valid() bool {
+  return g == FilterGlueAnd || g == FilterGlueOr
+}
+
+// fields returns a function looking up the fields of an object, listing
+// them once per object.
+func (s *FiltersService) fields(ctx context.Context) func(FilterObject) (*CustomFieldResolver, error) {
+  resolvers := make(map[FilterObject]*CustomFieldResolver)
+
+  return func(object FilterObject) (*CustomFieldResolver, error) {
+    if resolver, ok := resolvers[object]; ok {
+      return resolver, nil
+    }
+
+    var resolver *CustomFieldResolver
+    var err error
+
+    switch object {
+    case FilterObjectDeal:
+      resolver, err = s.client.DealFields.Resolver(ctx)
+    case FilterObjectPerson:
+      resolver, err = s.client.PersonFields.Resolver(ctx)
+    case FilterObjectOrganization:
+      resolver, err = s.client.OrganizationField.Resolver(ctx)
+    case FilterObjectProduct:
+      resolver, err = s.client.ProductFields.Resolver(ctx)
+    case FilterObjectActivity:
+      resolver, err = s.client.ActivityFields.Resolver(ctx)
+    default:
+      return nil, fmt.Errorf("%w: unknown object %q", ErrFilterCondition, object)
+    }
+
+    if err != nil {
+      return nil, fmt.Errorf("listing %v fields: %w", object, err)
+    }
+
+    resolvers[object] = resolver
+
+    return resolver, nil
+  }
+}
+
+// splitFilterExpression returns the conditions of the "and" and the "or"
+// groups Pipedrive expects.
+func splitFilterExpression(expr FilterExpression) ([]FilterCondition, []FilterCondition, error) {
+  var and, or []FilterCondition
+  var err error
+
+  switch e := expr.(type) {
+  case FilterCondition:
+    and = []FilterCondition{e}
+  case FilterGroup:
+    if e.Glue == FilterGlueOr {
+      or, err = filterGroupConditions(e)
+      break
+    }
+
+    for _, child := range e.Expressions {
+      switch c := child.(type) {
+      case FilterCondition:
+        and = append(and, c)
+      case FilterGroup:
+        if c.Glue == FilterGlueAnd {
+          conditions, err := filterGroupConditions(c)
+
+          if err != nil {
+            return nil, nil, err
+          }
+
+          and = append(and, conditions...)
+          continue
+        }
+
+        if or != nil {
+          return nil, nil, fmt.Errorf("%w: only one FilterOr is supported in FilterAnd", ErrFilterCondition)
+        }
+
+        if or, err = filterGroupConditions(c); err != nil {
+          return nil, nil, err
+        }
+      default:
+        return nil, nil, fmt.Errorf("%w: unsupported expression %T", ErrFilterCondition, child)
+      }
+    }
+  default:
+    return nil, nil, fmt.Errorf("%w: unsupported expression %T", ErrFilterCondition, expr)
+  }
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  if len(and) > filterGroupMaxConditions || len(or) > filterGroupMaxConditions {
+    return nil, nil, fmt.Errorf("%w: more than %d conditions in a group", ErrFilterCondition, filterGroupMaxConditions)
+  }
+
+  return and, or, nil
+}
+
+// filterGroupConditions returns the conditions of a group, which must not
+// contain other groups.
+func filterGroupConditions(group FilterGroup) ([]FilterCondition, error) {
+  conditions := make([]FilterCondition, 0, len(group.Expressions))
+
+  for _, expr := range group.Expressions {
+    condition, ok := expr.(FilterCondition)
+
+    if !ok {
+      return nil, fmt.Errorf("%w: groups nested in %q groups are not supported", ErrFilterCondition, group.Glue)
+    }
+
+    conditions = append(conditions, condition)
+  }
+
+  return conditions, nil
+}
+
+// entry resolves the field of the condition and validates the operator
+// and the value against its type.
+func (c FilterCondition) entry(fields func(FilterObject) (*CustomFieldResolver, error)) (FilterConditionEntry, error) {
+  resolver, err := fields(c.Object)
+
+  if err != nil {
+    return FilterConditionEntry{}, err
+  }
+
+  field, err := resolver.Field(c.Name)
+
+  if err != nil {
+    return FilterConditionEntry{}, fmt.Errorf("%v: %w", c.Object, err)
+  }
+
+  if operators, ok := filterOperatorsByType[field.FieldType]; ok && !containsFilterOperator(operators, c.Operator) {
+    return FilterConditionEntry{}, fmt.Errorf("%w: operator %v on %v field %q of type %v", ErrFilterCondition, c.Operator, c.Object, field.Name, field.FieldType)
+  }
+
+  value, err := c.value(field)
+
+  if err != nil {
+    return FilterConditionEntry{}, err
+  }
+
+  return FilterConditionEntry{
+    Object:     c.Object,
+    FieldID:    strconv.Itoa(field.ID),
+    Operator:   c.Operator,
+    Value:      value,
+    ExtraValue: c.ExtraValue,
+  }, nil
+}
+
+// value converts the value of the condition to the string Pipedrive
+// expects. Values of numeric fields must be numbers or numeric strings,
+// and values of date fields time.Time values or YYYY-MM-DD strings.
+func (c FilterCondition) value(field FieldDefinition) (interface{}, error) {
+  empty := c.Operator == FilterOperatorIsNull || c.Operator == FilterOperatorIsNotNull
+
+  if empty || c.Value == nil {
+    if !empty && c.ExtraValue == nil {
+      return nil, fmt.Errorf("%w: operator %v on field %q needs a value", ErrFilterCondition, c.Operator, field.Name)
+    }
+
+    return nil, nil
+  }
+
+  if t, ok := c.Value.(time.Time); ok {
+    if field.FieldType == FieldTypeTime {
+      return t.Format("15:04:05"), nil
+    }
+
+    return t.Format(fieldDateLayout), nil
+  }
+
+  if field.FieldType == FieldTypeEnum || field.FieldType == FieldTypeSet {
+    option, err := field.option(c.Value)
+
+    if err != nil {
+      return nil, err
+    }
+
+    return string(option.ID), nil
+  }
+
+  switch field.FieldType {
+  case FieldTypeDouble, FieldTypeMonetary, FieldTypeInt:
+    if !isFilterNumber(c.Value) {
+      return nil, fmt.Errorf("%w: field %q of type %v expects a number, got %#v", ErrFilterCondition, field.Name, field.FieldType, c.Value)
+    }
+  case FieldTypeDate, FieldTypeDaterange:
+    if s, ok := c.Value.(string); !ok || !isFilterDate(s) {
+      return nil, fmt.Errorf("%w: field %q of type %v expects a YYYY-MM-DD date, got %#v", ErrFilterCondition, field.Name, field.FieldType, c.Value)
+    }
+  }
+
+  return fmt.Sprint(normalizeNumber(c.Value)), nil
+}
+
+// isFilterNumber reports whether value is a number or a numeric string.
+func isFilterNumber(value interface{}) bool {
+  if s, ok := value.(string); ok {
+    _, err := strconv.ParseFloat(s, 64)
+    return err == nil
+  }
+
+  _, ok := numberValue(value)
+
+  return ok
+}
+
+func isFilterDate(s string) bool {
+  _, err := time.Parse(fieldDateLayout, s)
+
+  return err == nil
+}
+
+func containsFilterOperator(operators []FilterOperator, operator FilterOperator) bool {
+  for _, o := range operators {
+    if o == operator {
+      return true
+    }
+  }
+
+  return false
+}
@@ -30,19 +30,29 @@ func (f Filter) String() string {
   return Stringify(f)
 }
 
-// FilterConditions represents filter conditions.
+// FilterConditions represents filter conditions. Pipedrive expects two
+// groups glued with "and": all conditions of the first group must match,
+// and one of the conditions of the second group, if any. Use
+// FiltersService.Build to make them from FilterAnd and FilterOr.
 type FilterConditions struct {
-  Glue       string `json:"glue"`
-  Conditions []struct {
-    Glue       string `json:"glue"`
-    Conditions []struct {
-      Object     string      `json:"object"`
-      FieldID    string      `json:"field_id"`
-      Operator   string      `json:"operator"`
-      Value      string      `json:"value"`
-      ExtraValue interface{} `json:"extra_value"`
-    } `json:"conditions"`
-  } `json:"conditions"`
+  Glue       FilterGlue             `json:"glue"`
+  Conditions []FilterConditionGroup `json:"conditions"`
+}
+
+// FilterConditionGroup represents a group of filter conditions.
+type FilterConditionGroup struct {
+  Glue       FilterGlue             `json:"glue"`
+  Conditions []FilterConditionEntry `json:"conditions"`
+}
+
+// FilterConditionEntry represents a single filter condition. FieldID is
+// the ID of the field, not its key.
+type FilterConditionEntry struct {
+  Object     FilterObject   `json:"object"`
+  FieldID    string         `json:"field_id"`
+  Operator   FilterOperator `json:"operator"`
+  Value      interface{}    `json:"value"`
+  ExtraValue interface{}    `json:"extra_value"`
 }
 
 // FilterResponse represents single filter response.
@@ -71,7 +81,7 @@ type FiltersListOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Filters/get_filters
 func (s *FiltersService) List(ctx context.Context, opt *FiltersListOptions) (*FiltersResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/filters", opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/filters", opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -93,7 +103,7 @@ func (s *FiltersService) List(ctx context.Context, opt *FiltersListOptions) (*Fi
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Filters/get_filters_id
 func (s *FiltersService) GetByID(ctx context.Context, id int) (*FilterResponse, *Response, error) {
   uri := fmt.Sprintf("/filters/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -113,16 +123,16 @@ func (s *FiltersService) GetByID(ctx context.Context, id int) (*FilterResponse,
 // FilterCreateOptions specifices the optional parameters to the
 // FiltersService.Create method.
 type FilterCreateOptions struct {
-  Name       string `url:"name,omitempty"`
-  Conditions string `url:"conditions,omitempty"`
-  Type       string `url:"type,omitempty"`
+  Name       string            `json:"name,omitempty"`
+  Conditions *FilterConditions `json:"conditions,omitempty"`
+  Type       string            `json:"type,omitempty"`
 }
 
 // Create a filter.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Filters/post_filters
 func (s *FiltersService) Create(ctx context.Context, opt *FilterCreateOptions) (*FilterResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/filters", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/filters", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -142,8 +152,8 @@ func (s *FiltersService) Create(ctx context.Context, opt *FilterCreateOptions) (
 // FilterUpdateOptions specifices the optional parameters to the
 // FiltersService.Update method.
 type FilterUpdateOptions struct {
-  Name       string `url:"name,omitempty"`
-  Conditions string `url:"conditions,omitempty"`
+  Name       string            `json:"name,omitempty"`
+  Conditions *FilterConditions `json:"conditions,omitempty"`
 }
 
 // Update a specific filter.
@@ -151,7 +161,7 @@ type FilterUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Filters/put_filters_id
 func (s *FiltersService) Update(ctx context.Context, id int, opt *FilterUpdateOptions) (*FilterResponse, *Response, error) {
   uri := fmt.Sprintf("/filters/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -172,7 +182,7 @@ func (s *FiltersService) Update(ctx context.Context, id int, opt *FilterUpdateOp
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Filters/delete_filters
 func (s *FiltersService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/filter", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/filter", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -188,7 +198,7 @@ func (s *FiltersService) DeleteMultiple(ctx context.Context, ids []int) (*Respon
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Filters/delete_filters_id
 func (s *FiltersService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/filters/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -0,0 +1,162 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "errors"
+  "fmt"
+  "net/http"
+  "testing"
+  "time"
+
+  "github.com/go-test/deep"
+)
+
+func setupFilterFields(t *testing.T) *Client {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/dealFields", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success": true, "data": [
+      {"id": 12, "key": "value", "name": "Value", "field_type": "monetary"},
+      {"id": 13, "key": "status", "name": "Status", "field_type": "status"},
+      {"id": 14, "key": "close_time", "name": "Closed on", "field_type": "date"},
+      {"id": 40, "key": "abc123", "name": "Region", "field_type": "enum", "options": [{"id": 1, "label": "EMEA"}, {"id": 2, "label": "APAC"}]}
+    ]}`)
+  })
+
+  mux.HandleFunc("/personFields", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success": true, "data": [
+      {"id": 9, "key": "email", "name": "Email", "field_type": "varchar"}
+    ]}`)
+  })
+
+  return client
+}
+
+func TestFiltersService_Build(t *testing.T) {
+  client := setupFilterFields(t)
+
+  expr := FilterAnd(
+    FilterDeal("value").GreaterThan(1000),
+    FilterDeal("Closed on").LessThan(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+    FilterDeal("Region").Equal("APAC"),
+    FilterOr(
+      FilterPerson("email").Contains("@acme"),
+      FilterPerson("Email").IsEmpty(),
+    ),
+  )
+
+  conditions, err := client.Filters.Build(context.Background(), expr)
+
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  data, _ := json.Marshal(conditions)
+
+  want := `{"glue":"and","conditions":[` +
+    `{"glue":"and","conditions":[` +
+    `{"object":"deal","field_id":"12","operator":"\u003e","value":"1000","extra_value":null},` +
+    `{"object":"deal","field_id":"14","operator":"\u003c","value":"2024-02-01","extra_value":null},` +
+    `{"object":"deal","field_id":"40","operator":"=","value":"2","extra_value":null}]},` +
+    `{"glue":"or","conditions":[` +
+    `{"object":"person","field_id":"9","operator":"LIKE '%$%'","value":"@acme","extra_value":null},` +
+    `{"object":"person","field_id":"9","operator":"IS NULL","value":null,"extra_value":null}]}]}`
+
+  if string(data) != want {
+    t.Errorf("Build returned\n%s\nwant\n%s", data, want)
+  }
+
+  parsed, err := client.Filters.Parse(context.Background(), conditions)
+
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  wantParsed := FilterAnd(
+    FilterDeal("value").GreaterThan("1000"),
+    FilterDeal("close_time").LessThan("2024-02-01"),
+    FilterDeal("abc123").Equal("2"),
+    FilterOr(
+      FilterPerson("email").Contains("@acme"),
+      FilterPerson("email").IsEmpty(),
+    ),
+  )
+
+  if diff := deep.Equal(parsed, wantParsed); diff != nil {
+    t.Errorf("Parse: %v", diff)
+  }
+}
+
+func TestFiltersService_Build_invalid(t *testing.T) {
+  client := setupFilterFields(t)
+
+  tests := map[string]struct {
+    expr FilterExpression
+    want error
+  }{
+    "operator":      {FilterDeal("status").Contains("won"), ErrFilterCondition},
+    "option":        {FilterDeal("Region").Equal("LATAM"), ErrUnknownOption},
+    "field":         {FilterDeal("Nope").Equal(1), ErrUnknownField},
+    "missing value": {FilterDeal("value").GreaterThan(nil), ErrFilterCondition},
+    "nested or":     {FilterOr(FilterAnd(FilterDeal("value").Equal(1))), ErrFilterCondition},
+    "number":        {FilterDeal("value").GreaterThan("abc"), ErrFilterCondition},
+    "date":          {FilterDeal("Closed on").LessThan("01/02/2024"), ErrFilterCondition},
+    "two ors": {FilterAnd(
+      FilterOr(FilterDeal("value").Equal(1)),
+      FilterOr(FilterDeal("value").Equal(2)),
+    ), ErrFilterCondition},
+  }
+
+  for name, test := range tests {
+    if _, err := client.Filters.Build(context.Background(), test.expr); !errors.Is(err, test.want) {
+      t.Errorf("%v: Build returned %v, want %v", name, err, test.want)
+    }
+  }
+}
+
+func TestFiltersService_Parse_glue(t *testing.T) {
+  client := setupFilterFields(t)
+
+  var conditions FilterConditions
+
+  json.Unmarshal([]byte(`{"glue":"and","conditions":[`+
+    `{"glue":"or","conditions":[`+
+    `{"object":"person","field_id":"9","operator":"IS NULL","value":null,"extra_value":null},`+
+    `{"object":"deal","field_id":"12","operator":"=","value":"5","extra_value":null}]},`+
+    `{"glue":"and","conditions":[`+
+    `{"object":"deal","field_id":"40","operator":"=","value":"2","extra_value":null}]}]}`), &conditions)
+
+  parsed, err := client.Filters.Parse(context.Background(), &conditions)
+
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  want := FilterAnd(
+    FilterOr(
+      FilterPerson("email").IsEmpty(),
+      FilterDeal("value").Equal("5"),
+    ),
+    FilterDeal("abc123").Equal("2"),
+  )
+
+  if diff := deep.Equal(parsed, want); diff != nil {
+    t.Errorf("Parse: %v", diff)
+  }
+}
+
+func TestFiltersService_Parse_invalid(t *testing.T) {
+  client := setupFilterFields(t)
+
+  tests := map[string]*FilterConditions{
+    "nil":  nil,
+    "glue": {Glue: FilterGlueAnd, Conditions: []FilterConditionGroup{{Glue: "xor"}}},
+  }
+
+  for name, conditions := range tests {
+    if _, err := client.Filters.Parse(context.Background(), conditions); !errors.Is(err, ErrFilterCondition) {
+      t.Errorf("%v: Parse returned %v, want %v", name, err, ErrFilterCondition)
+    }
+  }
+}
@@ -0,0 +1,131 @@
+package pipedrive
+
+import (
+  "encoding/json"
+  "fmt"
+)
+
+// FlowObject is the kind of an update in the changelog of a deal, person
+// or organization.
+type FlowObject string
+
+const (
+  FlowDealChange                FlowObject = "dealChange"
+  FlowPersonChange              FlowObject = "personChange"
+  FlowOrganizationChange        FlowObject = "organizationChange"
+  FlowNote                      FlowObject = "note"
+  FlowActivity                  FlowObject = "activity"
+  FlowFile                      FlowObject = "file"
+  FlowMailMessage               FlowObject = "mailMessage"
+  FlowMailMessageWithAttachment FlowObject = "mailMessageWithAttachment"
+)
+
+// FlowData is the data of an update. It holds one of FieldChange, Note,
+// Activity, File or MailMessage.
+type FlowData interface {
+  flowData()
+}
+
+func (FieldChange) flowData() {}
+func (Note) flowData()        {}
+func (Activity) flowData()    {}
+func (File) flowData()        {}
+func (MailMessage) flowData() {}
+
+// FieldChange represents the change of a field.
+type FieldChange struct {
+  ID                    int         `json:"id"`
+  ItemID                int         `json:"item_id"`
+  UserID                int         `json:"user_id"`
+  FieldKey              string      `json:"field_key"`
+  OldValue              interface{} `json:"old_value"`
+  NewValue              interface{} `json:"new_value"`
+  IsBulkUpdateFlag      interface{} `json:"is_bulk_update_flag"`
+  LogTime               string      `json:"log_time"`
+  ChangeSource          string      `json:"change_source"`
+  ChangeSourceUserAgent string      `json:"change_source_user_agent"`
+  AdditionalData        struct {
+    OldValueFormatted string `json:"old_value_formatted"`
+    NewValueFormatted string `json:"new_value_formatted"`
+  } `json:"additional_data"`
+}
+
+func (c FieldChange) String() string {
+  return Stringify(c)
+}
+
+// FlowItem represents an update in a changelog. Data is nil for kinds of
+// updates not listed as FlowObject constants, in which case RawData holds
+// the undecoded data.
+//
+//   switch update := item.Data.(type) {
+//   case pipedrive.FieldChange:
+//   case pipedrive.Note:
+//   }
+type FlowItem struct {
+  Object    FlowObject      `json:"object"`
+  Timestamp string          `json:"timestamp"`
+  Data      FlowData        `json:"-"`
+  RawData   json.RawMessage `json:"data"`
+}
+
+func (i *FlowItem) UnmarshalJSON(data []byte) error {
+  type item FlowItem
+  var v item
+
+  if err := json.Unmarshal(data, &v); err != nil {
+    return err
+  }
+
+  *i = FlowItem(v)
+
+  var err error
+
+  switch i.Object {
+  case FlowDealChange, FlowPersonChange, FlowOrganizationChange:
+    i.Data, err = decodeFlowData[FieldChange](i.RawData)
+  case FlowNote:
+    i.Data, err = decodeFlowData[Note](i.RawData)
+  case FlowActivity:
+    i.Data, err = decodeFlowData[Activity](i.RawData)
+  case FlowFile:
+    i.Data, err = decodeFlowData[File](i.RawData)
+  case FlowMailMessage, FlowMailMessageWithAttachment:
+    i.Data, err = decodeFlowData[MailMessage](i.RawData)
+  }
+
+  if err != nil {
+    return fmt.Errorf("flow %v: %w", i.Object, err)
+  }
+
+  return nil
+}
+
+func decodeFlowData[T FlowData](data json.RawMessage) (FlowData, error) {
+  var v T
+
+  if err := json.Unmarshal(data, &v); err != nil {
+    return nil, err
+  }
+
+  return v, nil
+}
+
+// FlowResponse represents a changelog response.
+type FlowResponse struct {
+  Success        bool           `json:"success"`
+  Data           []FlowItem     `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// FlowOptions specifices the optional parameters to the ListUpdates
+// methods.
+type FlowOptions struct {
+  // Set to 1 to include the changes of all fields.
+  AllChanges string `url:"all_changes,omitempty"`
+
+  // Comma separated kinds of updates to return, e.g. "dealChange,note".
+  Items string `url:"items,omitempty"`
+
+  ListOptions
+}
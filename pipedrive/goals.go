@@ -66,7 +66,7 @@ type GoalsListOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals/get_goals
 func (s *GoalsService) List(ctx context.Context, opt *GoalsListOptions) (*GoalsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/goals", opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/goals", opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -88,7 +88,7 @@ func (s *GoalsService) List(ctx context.Context, opt *GoalsListOptions) (*GoalsR
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals/get_goals_id
 func (s *GoalsService) GetByID(ctx context.Context, id int) (*GoalResponse, *Response, error) {
   uri := fmt.Sprintf("/goals/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -122,7 +122,7 @@ type GoalCreateOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals/post_goals
 func (s *GoalsService) Create(ctx context.Context, opt *GoalCreateOptions) (*GoalResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/goals", opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/goals", opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -144,7 +144,7 @@ func (s *GoalsService) Create(ctx context.Context, opt *GoalCreateOptions) (*Goa
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals/put_goals_id
 func (s *GoalsService) Update(ctx context.Context, id int, opt *GoalCreateOptions) (*GoalResponse, *Response, error) {
   uri := fmt.Sprintf("/goals/%v", id)
-  req, err := s.client.NewRequest(http.MethodPost, uri, opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -173,7 +173,7 @@ type GoalGetResultsByIDOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals/get_goals_id_results
 func (s *GoalsService) GetResultsByID(ctx context.Context, id int, opt *GoalGetResultsByIDOptions) (*GoalsResponse, *Response, error) {
   uri := fmt.Sprintf("/goals/%v/results", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -195,7 +195,7 @@ func (s *GoalsService) GetResultsByID(ctx context.Context, id int, opt *GoalGetR
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals/delete_goals_id
 func (s *GoalsService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/goals/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -0,0 +1,546 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "strconv"
+)
+
+// ItemSearchService handles searching items of any type through
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/ItemSearch
+type ItemSearchService service
+
+// ItemType is the type of an item found by ItemSearchService.
+type ItemType string
+
+const (
+  ItemTypeDeal           ItemType = "deal"
+  ItemTypePerson         ItemType = "person"
+  ItemTypeOrganization   ItemType = "organization"
+  ItemTypeProduct        ItemType = "product"
+  ItemTypeLead           ItemType = "lead"
+  ItemTypeFile           ItemType = "file"
+  ItemTypeMailAttachment ItemType = "mail_attachment"
+  ItemTypeProject        ItemType = "project"
+)
+
+// SearchItem is an item found by ItemSearchService. It holds one of Deal,
+// Person, Organization, Product, Lead, File, MailAttachment or Project,
+// filled with the fields returned by the search.
+type SearchItem interface {
+  searchItem()
+}
+
+func (Deal) searchItem()           {}
+func (Person) searchItem()         {}
+func (Organization) searchItem()   {}
+func (Product) searchItem()        {}
+func (Lead) searchItem()           {}
+func (File) searchItem()           {}
+func (MailAttachment) searchItem() {}
+func (Project) searchItem()        {}
+
+// ItemSearchResult represents an item found by ItemSearchService.Search.
+// Item is nil for types not listed as ItemType constants, in which case
+// RawItem holds the undecoded item.
+//
+//   switch item := result.Item.(type) {
+//   case pipedrive.Deal:
+//   case pipedrive.Person:
+//   }
+type ItemSearchResult struct {
+  ResultScore float64         `json:"result_score"`
+  Type        ItemType        `json:"-"`
+  Item        SearchItem      `json:"-"`
+  RawItem     json.RawMessage `json:"item"`
+
+  // Custom field values and notes of the item matching the term.
+  MatchedCustomFields []string `json:"-"`
+  MatchedNotes        []string `json:"-"`
+}
+
+func (r ItemSearchResult) String() string {
+  return Stringify(r)
+}
+
+func (r *ItemSearchResult) UnmarshalJSON(data []byte) error {
+  type result ItemSearchResult
+  var v result
+
+  if err := json.Unmarshal(data, &v); err != nil {
+    return err
+  }
+
+  *r = ItemSearchResult(v)
+
+  var item searchItemData
+
+  if err := json.Unmarshal(r.RawItem, &item); err != nil {
+    return fmt.Errorf("search item: %w", err)
+  }
+
+  r.Type = item.Type
+  r.MatchedCustomFields = item.CustomFields
+  r.MatchedNotes = item.Notes
+
+  var err error
+
+  switch r.Type {
+  case ItemTypeDeal:
+    r.Item, err = item.deal()
+  case ItemTypePerson:
+    r.Item, err = item.person()
+  case ItemTypeOrganization:
+    r.Item, err = item.organization()
+  case ItemTypeProduct:
+    r.Item, err = item.product()
+  case ItemTypeLead:
+    r.Item, err = item.lead()
+  case ItemTypeFile:
+    r.Item, err = item.file()
+  case ItemTypeMailAttachment:
+    r.Item, err = item.mailAttachment()
+  case ItemTypeProject:
+    r.Item, err = item.project()
+  }
+
+  if err != nil {
+    return fmt.Errorf("search item %v: %w", r.Type, err)
+  }
+
+  return nil
+}
+
+// searchItemRef is a related item embedded in a search item.
+type searchItemRef struct {
+  ID      int    `json:"id"`
+  Name    string `json:"name"`
+  Title   string `json:"title"`
+  Address string `json:"address"`
+}
+
+// searchItemData holds the fields of search items of every type. Leads are
+// identified by strings and the other items by numbers.
+type searchItemData struct {
+  ID           json.RawMessage `json:"id"`
+  Type         ItemType        `json:"type"`
+  Title        string          `json:"title"`
+  Name         string          `json:"name"`
+  Description  string          `json:"description"`
+  Code         interface{}     `json:"code"`
+  Value        float64         `json:"value"`
+  Currency     string          `json:"currency"`
+  Status       string          `json:"status"`
+  Address      string          `json:"address"`
+  URL          string          `json:"url"`
+  VisibleTo    int             `json:"visible_to"`
+  IsArchived   bool            `json:"is_archived"`
+  Phones       []string        `json:"phones"`
+  Emails       []string        `json:"emails"`
+  CustomFields []string        `json:"custom_fields"`
+  Notes        []string        `json:"notes"`
+  Owner        *searchItemRef  `json:"owner"`
+  Stage        *searchItemRef  `json:"stage"`
+  Deal         *searchItemRef  `json:"deal"`
+  Person       *searchItemRef  `json:"person"`
+  Organization *searchItemRef  `json:"organization"`
+  Product      *searchItemRef  `json:"product"`
+}
+
+func (d *searchItemData) intID() (int, error) {
+  var id int
+
+  err := json.Unmarshal(d.ID, &id)
+
+  return id, err
+}
+
+func (d *searchItemData) visibleTo() string {
+  if d.VisibleTo == 0 {
+    return ""
+  }
+
+  return strconv.Itoa(d.VisibleTo)
+}
+
+func (d *searchItemData) deal() (Deal, error) {
+  id, err := d.intID()
+
+  deal := Deal{
+    ID:        id,
+    Title:     d.Title,
+    Value:     int(d.Value),
+    Currency:  d.Currency,
+    Status:    d.Status,
+    VisibleTo: d.visibleTo(),
+  }
+
+  if d.Owner != nil {
+    deal.UserID.ID = d.Owner.ID
+  }
+
+  if d.Stage != nil {
+    deal.StageID = d.Stage.ID
+  }
+
+  if d.Person != nil {
+    deal.PersonID.Value = d.Person.ID
+    deal.PersonID.Name = d.Person.Name
+    deal.PersonName = d.Person.Name
+  }
+
+  if d.Organization != nil {
+    deal.OrgID.Value = d.Organization.ID
+    deal.OrgID.Name = d.Organization.Name
+    deal.OrgName = d.Organization.Name
+  }
+
+  return deal, err
+}
+
+// person fills OrgID with the ID of the organization of the person.
+func (d *searchItemData) person() (Person, error) {
+  id, err := d.intID()
+
+  person := Person{
+    ID:        id,
+    Name:      d.Name,
+    VisibleTo: d.visibleTo(),
+  }
+
+  if d.Owner != nil {
+    person.OwnerID.ID = d.Owner.ID
+  }
+
+  if d.Organization != nil {
+    person.OrgID = d.Organization.ID
+    person.OrgName = d.Organization.Name
+  }
+
+  if len(d.Phones) > 0 {
+    person.Phone = make([]struct {
+      Value   string `json:"value,omitempty"`
+      Primary bool   `json:"primary,omitempty"`
+    }, len(d.Phones))
+
+    for i, phone := range d.Phones {
+      person.Phone[i].Value = phone
+      person.Phone[i].Primary = i == 0
+    }
+  }
+
+  if len(d.Emails) > 0 {
+    person.Email = make([]struct {
+      Value   string `json:"value,omitempty"`
+      Primary bool   `json:"primary,omitempty"`
+    }, len(d.Emails))
+
+    for i, email := range d.Emails {
+      person.Email[i].Value = email
+      person.Email[i].Primary = i == 0
+    }
+  }
+
+  return person, err
+}
+
+func (d *searchItemData) organization() (Organization, error) {
+  id, err := d.intID()
+
+  org := Organization{
+    ID:        id,
+    Name:      d.Name,
+    VisibleTo: d.visibleTo(),
+  }
+
+  if d.Address != "" {
+    org.Address = d.Address
+  }
+
+  if d.Owner != nil {
+    org.OwnerID.ID = d.Owner.ID
+  }
+
+  return org, err
+}
+
+func (d *searchItemData) product() (Product, error) {
+  id, err := d.intID()
+
+  product := Product{
+    ID:        id,
+    Name:      d.Name,
+    Code:      d.Code,
+    VisibleTo: d.visibleTo(),
+  }
+
+  if d.Owner != nil {
+    product.OwnerID.ID = d.Owner.ID
+  }
+
+  return product, err
+}
+
+func (d *searchItemData) lead() (Lead, error) {
+  var id string
+
+  err := json.Unmarshal(d.ID, &id)
+
+  lead := Lead{
+    ID:         id,
+    Title:      d.Title,
+    VisibleTo:  d.visibleTo(),
+    IsArchived: d.IsArchived,
+  }
+
+  if d.Owner != nil {
+    lead.OwnerID = d.Owner.ID
+  }
+
+  if d.Person != nil {
+    lead.PersonID = &d.Person.ID
+  }
+
+  if d.Organization != nil {
+    lead.OrganizationID = &d.Organization.ID
+  }
+
+  if d.Currency != "" {
+    lead.Value = &LeadValue{Amount: d.Value, Currency: d.Currency}
+  }
+
+  return lead, err
+}
+
+func (d *searchItemData) file() (File, error) {
+  id, err := d.intID()
+
+  file := File{
+    ID:   id,
+    Name: d.Name,
+    URL:  d.URL,
+  }
+
+  if d.Deal != nil {
+    file.DealID = d.Deal.ID
+    file.DealName = d.Deal.Title
+  }
+
+  if d.Person != nil {
+    file.PersonID = d.Person.ID
+    file.PersonName = d.Person.Name
+  }
+
+  if d.Organization != nil {
+    file.OrgID = d.Organization.ID
+    file.OrgName = d.Organization.Name
+  }
+
+  if d.Product != nil {
+    file.ProductID = d.Product.ID
+    file.ProductName = d.Product.Name
+  }
+
+  return file, err
+}
+
+func (d *searchItemData) mailAttachment() (MailAttachment, error) {
+  id, err := d.intID()
+
+  return MailAttachment{
+    ID:       id,
+    FileName: d.Name,
+    URL:      d.URL,
+  }, err
+}
+
+func (d *searchItemData) project() (Project, error) {
+  id, err := d.intID()
+
+  project := Project{
+    ID:          id,
+    Title:       d.Title,
+    Description: d.Description,
+    Status:      ProjectStatus(d.Status),
+  }
+
+  if d.Owner != nil {
+    project.OwnerID = d.Owner.ID
+  }
+
+  if d.Person != nil {
+    project.PersonID = &d.Person.ID
+  }
+
+  if d.Organization != nil {
+    project.OrgID = &d.Organization.ID
+  }
+
+  return project, err
+}
+
+// ItemSearchResponse represents the item search response.
+type ItemSearchResponse struct {
+  Success bool `json:"success"`
+  Data    struct {
+    Items []ItemSearchResult `json:"items"`
+
+    // Items related to the found ones, when SearchForRelatedItems is set.
+    RelatedItems []ItemSearchResult `json:"related_items"`
+  } `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// ItemSearchOptions specifices the optional parameters to the
+// ItemSearchService.Search method. Term must be at least 2 characters
+// long, or 1 with ExactMatch.
+type ItemSearchOptions struct {
+  Term string `url:"term"`
+
+  // Comma separated item types to search, e.g. "deal,person". All types
+  // are searched when empty.
+  ItemTypes string `url:"item_types,omitempty"`
+
+  // Comma separated fields to search in, e.g. "title,custom_fields".
+  Fields string `url:"fields,omitempty"`
+
+  ExactMatch bool `url:"exact_match,omitempty"`
+
+  // Comma separated optional fields to return, e.g. "deal.cc_email".
+  IncludeFields string `url:"include_fields,omitempty"`
+
+  // Also return the items related to the found ones, e.g. the persons
+  // of found organizations.
+  SearchForRelatedItems bool `url:"search_for_related_items,omitempty"`
+
+  ListOptions
+}
+
+// Search items of all types by a term.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/ItemSearch#searchItem
+func (s *ItemSearchService) Search(ctx context.Context, opt *ItemSearchOptions) (*ItemSearchResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/itemSearch", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ItemSearchResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// SearchAll returns an iterator over all items found by a search. Related
+// items are not returned.
+func (s *ItemSearchService) SearchAll(ctx context.Context, opt *ItemSearchOptions) *Iterator[ItemSearchResult] {
+  var filters ItemSearchOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]ItemSearchResult, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.Search(ctx, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data.Items, &record.AdditionalData, resp, nil
+  })
+}
+
+// SearchFieldType is the type of the field searched by
+// ItemSearchService.SearchField.
+type SearchFieldType string
+
+const (
+  SearchFieldDeal         SearchFieldType = "dealField"
+  SearchFieldLead         SearchFieldType = "leadField"
+  SearchFieldPerson       SearchFieldType = "personField"
+  SearchFieldOrganization SearchFieldType = "organizationField"
+  SearchFieldProduct      SearchFieldType = "productField"
+  SearchFieldProject      SearchFieldType = "projectField"
+)
+
+// ItemFieldSearchResult represents a value found by
+// ItemSearchService.SearchField. ID is only set with ReturnItemIDs.
+type ItemFieldSearchResult struct {
+  ID    int
+  Value interface{}
+}
+
+// ItemFieldSearchResponse represents the field search response.
+type ItemFieldSearchResponse struct {
+  Success        bool                     `json:"success"`
+  Data           []ItemFieldSearchResult  `json:"-"`
+  RawData        []map[string]interface{} `json:"data"`
+  AdditionalData AdditionalData           `json:"additional_data"`
+}
+
+// ItemFieldSearchOptions specifices the optional parameters to the
+// ItemSearchService.SearchField method. Term, FieldType and FieldKey are
+// required.
+type ItemFieldSearchOptions struct {
+  Term       string          `url:"term"`
+  FieldType  SearchFieldType `url:"field_type"`
+  FieldKey   string          `url:"field_key"`
+  ExactMatch bool            `url:"exact_match,omitempty"`
+
+  // Return the ID of the item holding each value, instead of distinct
+  // values only.
+  ReturnItemIDs bool `url:"return_item_ids,omitempty"`
+
+  ListOptions
+}
+
+// SearchField searches the values of a field, e.g. to autocomplete it.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/ItemSearch#searchItemByField
+func (s *ItemSearchService) SearchField(ctx context.Context, opt *ItemFieldSearchOptions) (*ItemFieldSearchResponse, *Response, error) {
+  if opt == nil || opt.FieldKey == "" {
+    return nil, nil, fmt.Errorf("searching a field requires a field key")
+  }
+
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/itemSearch/field", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ItemFieldSearchResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  if record != nil {
+    record.Data = make([]ItemFieldSearchResult, len(record.RawData))
+
+    for i, values := range record.RawData {
+      if id, ok := values["id"].(float64); ok {
+        record.Data[i].ID = int(id)
+      }
+
+      record.Data[i].Value = values[opt.FieldKey]
+    }
+  }
+
+  return record, resp, nil
+}
@@ -0,0 +1,81 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+  "testing"
+)
+
+func TestItemSearchService_Search(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/itemSearch", func(w http.ResponseWriter, r *http.Request) {
+    if got := r.URL.Query().Get("item_types"); got != "deal,person,lead" {
+      t.Errorf("item_types = %q, want deal,person,lead", got)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":{"items":[
+      {"result_score":1.2,"item":{"id":1,"type":"deal","title":"ACME renewal","value":1500,"currency":"EUR",
+        "owner":{"id":3},"person":{"id":4,"name":"Jane"},"organization":{"id":5,"name":"ACME"},"custom_fields":["acme"]}},
+      {"result_score":0.8,"item":{"id":4,"type":"person","name":"Jane","emails":["jane@acme.com"],"organization":{"id":5,"name":"ACME"}}},
+      {"result_score":0.5,"item":{"id":"adf21080-0e10-11eb-879b-05d71fb426ec","type":"lead","title":"ACME","value":10,"currency":"EUR"}},
+      {"result_score":0.1,"item":{"id":9,"type":"board"}}
+    ]}}`)
+  })
+
+  record, _, err := client.ItemSearch.Search(context.Background(), &ItemSearchOptions{Term: "acme", ItemTypes: "deal,person,lead"})
+
+  if err != nil {
+    t.Fatalf("Search returned error: %v", err)
+  }
+
+  items := record.Data.Items
+
+  if len(items) != 4 {
+    t.Fatalf("Search returned %d items, want 4", len(items))
+  }
+
+  if deal, ok := items[0].Item.(Deal); !ok || deal.Value != 1500 || deal.PersonID.Value != 4 || deal.OrgName != "ACME" || items[0].MatchedCustomFields[0] != "acme" {
+    t.Errorf("Search returned deal %+v", items[0])
+  }
+
+  if person, ok := items[1].Item.(Person); !ok || person.Email[0].Value != "jane@acme.com" || person.OrgName != "ACME" {
+    t.Errorf("Search returned person %+v", items[1])
+  }
+
+  if lead, ok := items[2].Item.(Lead); !ok || lead.ID != "adf21080-0e10-11eb-879b-05d71fb426ec" || lead.Value.Amount != 10 {
+    t.Errorf("Search returned lead %+v", items[2])
+  }
+
+  if items[3].Item != nil || items[3].Type != "board" || len(items[3].RawItem) == 0 {
+    t.Errorf("Search returned unknown item %+v", items[3])
+  }
+}
+
+func TestItemSearchService_SearchField(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/itemSearch/field", func(w http.ResponseWriter, r *http.Request) {
+    if got := r.URL.Query().Get("field_key"); got != "name" {
+      t.Errorf("field_key = %q, want name", got)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":[{"id":5,"name":"ACME"},{"id":6,"name":"ACME Labs"}]}`)
+  })
+
+  record, _, err := client.ItemSearch.SearchField(context.Background(), &ItemFieldSearchOptions{
+    Term:          "acme",
+    FieldType:     SearchFieldOrganization,
+    FieldKey:      "name",
+    ReturnItemIDs: true,
+  })
+
+  if err != nil {
+    t.Fatalf("SearchField returned error: %v", err)
+  }
+
+  if len(record.Data) != 2 || record.Data[1].ID != 6 || record.Data[1].Value != "ACME Labs" {
+    t.Errorf("SearchField returned %+v", record.Data)
+  }
+}
@@ -0,0 +1,135 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+)
+
+// LeadLabelsService handles lead labels related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/LeadLabels
+type LeadLabelsService service
+
+// LeadLabelColor is the color of a lead label.
+type LeadLabelColor string
+
+const (
+  LeadLabelGreen  LeadLabelColor = "green"
+  LeadLabelBlue   LeadLabelColor = "blue"
+  LeadLabelRed    LeadLabelColor = "red"
+  LeadLabelYellow LeadLabelColor = "yellow"
+  LeadLabelPurple LeadLabelColor = "purple"
+  LeadLabelGray   LeadLabelColor = "gray"
+)
+
+// LeadLabel represents a Pipedrive lead label.
+type LeadLabel struct {
+  ID         string         `json:"id"`
+  Name       string         `json:"name"`
+  Color      LeadLabelColor `json:"color"`
+  AddTime    string         `json:"add_time"`
+  UpdateTime string         `json:"update_time"`
+}
+
+func (l LeadLabel) String() string {
+  return Stringify(l)
+}
+
+// LeadLabelsResponse represents multiple lead labels response.
+type LeadLabelsResponse struct {
+  Success bool        `json:"success"`
+  Data    []LeadLabel `json:"data"`
+}
+
+// LeadLabelResponse represents single lead label response.
+type LeadLabelResponse struct {
+  Success bool      `json:"success"`
+  Data    LeadLabel `json:"data"`
+}
+
+// List all lead labels.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/LeadLabels#getLeadLabels
+func (s *LeadLabelsService) List(ctx context.Context) (*LeadLabelsResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/leadLabels", nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadLabelsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// LeadLabelOptions specifices the optional parameters to the
+// LeadLabelsService.Create and LeadLabelsService.Update methods.
+type LeadLabelOptions struct {
+  Name  string         `json:"name,omitempty"`
+  Color LeadLabelColor `json:"color,omitempty"`
+}
+
+// Create a lead label.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/LeadLabels#addLeadLabel
+func (s *LeadLabelsService) Create(ctx context.Context, opt *LeadLabelOptions) (*LeadLabelResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/leadLabels", nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadLabelResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Update a lead label.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/LeadLabels#updateLeadLabel
+func (s *LeadLabelsService) Update(ctx context.Context, id string, opt *LeadLabelOptions) (*LeadLabelResponse, *Response, error) {
+  uri := fmt.Sprintf("/leadLabels/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPatch, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadLabelResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Delete a lead label.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/LeadLabels#deleteLeadLabel
+func (s *LeadLabelsService) Delete(ctx context.Context, id string) (*Response, error) {
+  uri := fmt.Sprintf("/leadLabels/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
+
+  if err != nil {
+    return nil, err
+  }
+
+  return s.client.Do(ctx, req, nil)
+}
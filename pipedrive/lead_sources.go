@@ -0,0 +1,48 @@
+package pipedrive
+
+import (
+  "context"
+  "net/http"
+)
+
+// LeadSourcesService handles lead sources related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/LeadSources
+type LeadSourcesService service
+
+// LeadSource represents a Pipedrive lead source.
+type LeadSource struct {
+  Name string `json:"name"`
+}
+
+func (l LeadSource) String() string {
+  return Stringify(l)
+}
+
+// LeadSourcesResponse represents multiple lead sources response.
+type LeadSourcesResponse struct {
+  Success bool         `json:"success"`
+  Data    []LeadSource `json:"data"`
+}
+
+// List all lead sources.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/LeadSources#getLeadSources
+func (s *LeadSourcesService) List(ctx context.Context) (*LeadSourcesResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/leadSources", nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadSourcesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
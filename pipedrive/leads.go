@@ -0,0 +1,471 @@
+package pipedrive
+
+import (
+  "context"
+  "errors"
+  "fmt"
+  "net/http"
+  "strconv"
+)
+
+// LeadsService handles leads related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Leads
+type LeadsService service
+
+// LeadArchivedStatus filters leads by their archived state.
+type LeadArchivedStatus string
+
+const (
+  LeadArchived    LeadArchivedStatus = "archived"
+  LeadNotArchived LeadArchivedStatus = "not_archived"
+  LeadAllStatuses LeadArchivedStatus = "all"
+)
+
+// LeadValue represents the potential value of a lead.
+type LeadValue struct {
+  Amount   float64 `json:"amount"`
+  Currency string  `json:"currency"`
+}
+
+// Lead represents a Pipedrive lead. Leads are identified by UUIDs.
+type Lead struct {
+  ID                string     `json:"id"`
+  Title             string     `json:"title"`
+  OwnerID           int        `json:"owner_id"`
+  CreatorID         int        `json:"creator_id"`
+  LabelIDs          []string   `json:"label_ids"`
+  PersonID          *int       `json:"person_id"`
+  OrganizationID    *int       `json:"organization_id"`
+  SourceName        string     `json:"source_name"`
+  Origin            string     `json:"origin"`
+  Channel           *int       `json:"channel"`
+  IsArchived        bool       `json:"is_archived"`
+  WasSeen           bool       `json:"was_seen"`
+  Value             *LeadValue `json:"value"`
+  ExpectedCloseDate string     `json:"expected_close_date"`
+  NextActivityID    *int       `json:"next_activity_id"`
+  AddTime           string     `json:"add_time"`
+  UpdateTime        string     `json:"update_time"`
+  VisibleTo         string     `json:"visible_to"`
+  CcEmail           string     `json:"cc_email"`
+
+  // Values of deal custom fields, keyed by their hash.
+  CustomFields map[string]interface{} `json:"-"`
+}
+
+func (l Lead) String() string {
+  return Stringify(l)
+}
+
+func (l *Lead) UnmarshalJSON(data []byte) error {
+  type lead Lead
+  var v lead
+
+  custom, err := unmarshalCustomFields(data, &v)
+
+  if err != nil {
+    return err
+  }
+
+  *l = Lead(v)
+  l.CustomFields = custom
+
+  return nil
+}
+
+func (l Lead) MarshalJSON() ([]byte, error) {
+  type lead Lead
+
+  return marshalCustomFields(lead(l), l.CustomFields)
+}
+
+// LeadsResponse represents multiple leads response.
+type LeadsResponse struct {
+  Success        bool           `json:"success"`
+  Data           []Lead         `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// LeadResponse represents single lead response.
+type LeadResponse struct {
+  Success bool `json:"success"`
+  Data    Lead `json:"data"`
+}
+
+// LeadDeleteResponse represents the response of a deleted lead.
+type LeadDeleteResponse struct {
+  Success bool `json:"success"`
+  Data    struct {
+    ID string `json:"id"`
+  } `json:"data"`
+}
+
+// LeadSearchItem represents a lead found by LeadsService.Search.
+type LeadSearchItem struct {
+  ResultScore float64 `json:"result_score"`
+  Item        struct {
+    ID    string `json:"id"`
+    Type  string `json:"type"`
+    Title string `json:"title"`
+    Owner struct {
+      ID int `json:"id"`
+    } `json:"owner"`
+    Person *struct {
+      ID   int    `json:"id"`
+      Name string `json:"name"`
+    } `json:"person"`
+    Organization *struct {
+      ID   int    `json:"id"`
+      Name string `json:"name"`
+    } `json:"organization"`
+    Phones       []string `json:"phones"`
+    Emails       []string `json:"emails"`
+    CustomFields []string `json:"custom_fields"`
+    Notes        []string `json:"notes"`
+    Value        float64  `json:"value"`
+    Currency     string   `json:"currency"`
+    VisibleTo    int      `json:"visible_to"`
+    IsArchived   bool     `json:"is_archived"`
+  } `json:"item"`
+}
+
+// LeadsSearchResponse represents the leads search response.
+type LeadsSearchResponse struct {
+  Success bool `json:"success"`
+  Data    struct {
+    Items []LeadSearchItem `json:"items"`
+  } `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// LeadsListOptions specifices the optional parameters to the
+// LeadsService.List method.
+type LeadsListOptions struct {
+  ArchivedStatus LeadArchivedStatus `url:"archived_status,omitempty"`
+  OwnerID        uint               `url:"owner_id,omitempty"`
+  PersonID       uint               `url:"person_id,omitempty"`
+  OrganizationID uint               `url:"organization_id,omitempty"`
+  FilterID       uint               `url:"filter_id,omitempty"`
+
+  // Field names and sorting mode, e.g. "title ASC, add_time DESC".
+  Sort string `url:"sort,omitempty"`
+
+  ListOptions
+}
+
+// List leads.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Leads#getLeads
+func (s *LeadsService) List(ctx context.Context, opt *LeadsListOptions) (*LeadsResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/leads", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAll returns an iterator over all leads matching opt.
+func (s *LeadsService) ListAll(ctx context.Context, opt *LeadsListOptions) *Iterator[Lead] {
+  var filters LeadsListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]Lead, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.List(ctx, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// Get a specific lead.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Leads#getLead
+func (s *LeadsService) Get(ctx context.Context, id string) (*LeadResponse, *Response, error) {
+  uri := fmt.Sprintf("/leads/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// LeadCreateOptions specifices the optional parameters to the
+// LeadsService.Create method. Either PersonID or OrganizationID is required.
+type LeadCreateOptions struct {
+  Title             string     `json:"title"`
+  OwnerID           uint       `json:"owner_id,omitempty"`
+  LabelIDs          []string   `json:"label_ids,omitempty"`
+  PersonID          uint       `json:"person_id,omitempty"`
+  OrganizationID    uint       `json:"organization_id,omitempty"`
+  Value             *LeadValue `json:"value,omitempty"`
+  ExpectedCloseDate string     `json:"expected_close_date,omitempty"`
+  VisibleTo         string     `json:"visible_to,omitempty"`
+  WasSeen           bool       `json:"was_seen,omitempty"`
+
+  // Values of deal custom fields keyed by field key, see CustomFieldResolver.
+  CustomFields map[string]interface{} `json:"-"`
+}
+
+func (o LeadCreateOptions) MarshalJSON() ([]byte, error) {
+  type leadCreateOptions LeadCreateOptions
+
+  return marshalCustomFields(leadCreateOptions(o), o.CustomFields)
+}
+
+// Create a lead.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Leads#addLead
+func (s *LeadsService) Create(ctx context.Context, opt *LeadCreateOptions) (*LeadResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/leads", nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// LeadUpdateOptions specifices the optional parameters to the
+// LeadsService.Update method. Only the set fields are changed.
+type LeadUpdateOptions struct {
+  Title             string     `json:"title,omitempty"`
+  OwnerID           uint       `json:"owner_id,omitempty"`
+  LabelIDs          []string   `json:"label_ids,omitempty"`
+  PersonID          uint       `json:"person_id,omitempty"`
+  OrganizationID    uint       `json:"organization_id,omitempty"`
+  IsArchived        *bool      `json:"is_archived,omitempty"`
+  Value             *LeadValue `json:"value,omitempty"`
+  ExpectedCloseDate string     `json:"expected_close_date,omitempty"`
+  VisibleTo         string     `json:"visible_to,omitempty"`
+  WasSeen           *bool      `json:"was_seen,omitempty"`
+
+  // Values of deal custom fields keyed by field key, see CustomFieldResolver.
+  CustomFields map[string]interface{} `json:"-"`
+}
+
+func (o LeadUpdateOptions) MarshalJSON() ([]byte, error) {
+  type leadUpdateOptions LeadUpdateOptions
+
+  return marshalCustomFields(leadUpdateOptions(o), o.CustomFields)
+}
+
+// Update a lead.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Leads#updateLead
+func (s *LeadsService) Update(ctx context.Context, id string, opt *LeadUpdateOptions) (*LeadResponse, *Response, error) {
+  uri := fmt.Sprintf("/leads/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPatch, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Delete a lead.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Leads#deleteLead
+func (s *LeadsService) Delete(ctx context.Context, id string) (*LeadDeleteResponse, *Response, error) {
+  uri := fmt.Sprintf("/leads/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadDeleteResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// LeadsSearchOptions specifices the optional parameters to the
+// LeadsService.Search method.
+type LeadsSearchOptions struct {
+  Term           string `url:"term"`
+  Fields         string `url:"fields,omitempty"`
+  ExactMatch     bool   `url:"exact_match,omitempty"`
+  PersonID       uint   `url:"person_id,omitempty"`
+  OrganizationID uint   `url:"organization_id,omitempty"`
+  IncludeFields  string `url:"include_fields,omitempty"`
+
+  ListOptions
+}
+
+// Search leads by title, notes and custom fields.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Leads#searchLeads
+func (s *LeadsService) Search(ctx context.Context, opt *LeadsSearchOptions) (*LeadsSearchResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/leads/search", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *LeadsSearchResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// LeadConvertOptions specifices the optional parameters to the
+// LeadsService.ConvertToDeal method.
+type LeadConvertOptions struct {
+  // Deal settings taking precedence over the ones copied from the lead.
+  Deal DealCreateOptions
+
+  // Delete the lead once the deal is created, as the Pipedrive web app
+  // does.
+  DeleteLead bool
+}
+
+// ConvertToDeal creates a deal from a lead. The title, value, person,
+// organization, owner, expected close date and custom fields of the lead
+// are copied unless opt sets them. Only custom fields that are also deal
+// fields are copied.
+//
+// The lead is kept unless opt.DeleteLead is set. When the deal is created
+// but the lead can not be deleted, the deal is returned along with the
+// error: delete the lead with Delete rather than converting it again,
+// which would create a second deal.
+func (s *LeadsService) ConvertToDeal(ctx context.Context, id string, opt *LeadConvertOptions) (*DealResponse, *Response, error) {
+  var options LeadConvertOptions
+
+  if opt != nil {
+    options = *opt
+  }
+
+  lead, resp, err := s.Get(ctx, id)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  if lead == nil {
+    return nil, resp, errors.New("lead not found")
+  }
+
+  deal := options.Deal
+
+  if deal.Title == "" {
+    deal.Title = lead.Data.Title
+  }
+
+  if deal.Value == "" && lead.Data.Value != nil {
+    deal.Value = strconv.FormatFloat(lead.Data.Value.Amount, 'f', -1, 64)
+
+    if deal.Currency == "" {
+      deal.Currency = lead.Data.Value.Currency
+    }
+  }
+
+  if deal.PersonID == 0 && lead.Data.PersonID != nil {
+    deal.PersonID = *lead.Data.PersonID
+  }
+
+  if deal.OrganizationID == 0 && lead.Data.OrganizationID != nil {
+    deal.OrganizationID = *lead.Data.OrganizationID
+  }
+
+  if deal.UserID == 0 {
+    deal.UserID = lead.Data.OwnerID
+  }
+
+  if deal.ExpectedCloseDate == "" {
+    deal.ExpectedCloseDate = lead.Data.ExpectedCloseDate
+  }
+
+  if len(lead.Data.CustomFields) > 0 {
+    fields, err := s.client.DealFields.Resolver(ctx)
+
+    if err != nil {
+      return nil, resp, err
+    }
+
+    custom := make(map[string]interface{}, len(lead.Data.CustomFields)+len(deal.CustomFields))
+
+    for k, v := range lead.Data.CustomFields {
+      if fields.hasKey(k) {
+        custom[k] = v
+      }
+    }
+
+    for k, v := range deal.CustomFields {
+      custom[k] = v
+    }
+
+    deal.CustomFields = custom
+  }
+
+  created, resp, err := s.client.Deals.Add(ctx, &deal)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  if !options.DeleteLead {
+    return created, resp, nil
+  }
+
+  _, resp, err = s.Delete(ctx, id)
+
+  return created, resp, err
+}
@@ -0,0 +1,95 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "testing"
+)
+
+const testLeadID = "adf21080-0e10-11eb-879b-05d71fb426ec"
+
+func TestLeadsService_List(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/leads", func(w http.ResponseWriter, r *http.Request) {
+    if got := r.URL.Query().Get("archived_status"); got != "not_archived" {
+      t.Errorf("archived_status = %q, want not_archived", got)
+    }
+
+    fmt.Fprintf(w, `{"success":true,"data":[{"id":%q,"title":"Lead","person_id":4,"organization_id":null,"value":{"amount":99.5,"currency":"EUR"}}]}`, testLeadID)
+  })
+
+  record, _, err := client.Leads.List(context.Background(), &LeadsListOptions{ArchivedStatus: LeadNotArchived})
+
+  if err != nil {
+    t.Fatalf("List returned error: %v", err)
+  }
+
+  lead := record.Data[0]
+
+  if lead.ID != testLeadID || *lead.PersonID != 4 || lead.OrganizationID != nil {
+    t.Errorf("List returned %+v", lead)
+  }
+
+  if lead.Value == nil || lead.Value.Amount != 99.5 || lead.Value.Currency != "EUR" {
+    t.Errorf("List returned value %+v", lead.Value)
+  }
+}
+
+func TestLeadsService_ConvertToDeal(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  deleted := false
+
+  mux.HandleFunc("/leads/"+testLeadID, func(w http.ResponseWriter, r *http.Request) {
+    switch r.Method {
+    case http.MethodGet:
+      fmt.Fprintf(w, `{"success":true,"data":{"id":%q,"title":"Lead","owner_id":3,"person_id":4,"value":{"amount":1500,"currency":"USD"},"abc":"x","def":20,"def_currency":"EUR","origin_id":null,"channel":7}}`, testLeadID)
+    case http.MethodDelete:
+      deleted = true
+      fmt.Fprintf(w, `{"success":true,"data":{"id":%q}}`, testLeadID)
+    }
+  })
+
+  mux.HandleFunc("/dealFields", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":[{"id":1,"key":"abc","name":"Code","field_type":"varchar"},{"id":2,"key":"def","name":"Budget","field_type":"monetary"}]}`)
+  })
+
+  mux.HandleFunc("/deals", func(w http.ResponseWriter, r *http.Request) {
+    var body map[string]interface{}
+    json.NewDecoder(r.Body).Decode(&body)
+
+    if body["title"] != "Big deal" || body["value"] != "1500" || body["currency"] != "USD" ||
+      body["person_id"] != 4.0 || body["user_id"] != 3.0 || body["abc"] != "x" ||
+      body["def"] != 20.0 || body["def_currency"] != "EUR" {
+      t.Errorf("deal created with %v", body)
+    }
+
+    for _, key := range []string{"origin_id", "channel"} {
+      if _, ok := body[key]; ok {
+        t.Errorf("deal created with lead field %v", key)
+      }
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":{"id":10,"title":"Big deal"}}`)
+  })
+
+  opt := &LeadConvertOptions{Deal: DealCreateOptions{Title: "Big deal"}}
+  deal, _, err := client.Leads.ConvertToDeal(context.Background(), testLeadID, opt)
+
+  if err != nil {
+    t.Fatalf("ConvertToDeal returned error: %v", err)
+  }
+
+  if deal.Data.ID != 10 || deleted {
+    t.Errorf("ConvertToDeal returned %+v, lead deleted: %v", deal.Data, deleted)
+  }
+
+  opt.DeleteLead = true
+
+  if _, _, err := client.Leads.ConvertToDeal(context.Background(), testLeadID, opt); err != nil || !deleted {
+    t.Errorf("ConvertToDeal returned %v, lead deleted: %v", err, deleted)
+  }
+}
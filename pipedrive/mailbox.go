@@ -0,0 +1,373 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+)
+
+// MailboxService handles mailbox related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Mailbox
+type MailboxService service
+
+// MailFolder is a mailbox folder threads are listed from.
+type MailFolder string
+
+const (
+  MailFolderInbox   MailFolder = "inbox"
+  MailFolderDrafts  MailFolder = "drafts"
+  MailFolderSent    MailFolder = "sent"
+  MailFolderArchive MailFolder = "archive"
+)
+
+// MailParticipant represents a sender or recipient of a mail message.
+type MailParticipant struct {
+  ID                 int    `json:"id"`
+  EmailAddress       string `json:"email_address"`
+  Name               string `json:"name"`
+  LinkedPersonID     int    `json:"linked_person_id"`
+  LinkedPersonName   string `json:"linked_person_name"`
+  MailMessagePartyID int    `json:"mail_message_party_id"`
+
+  // Set on the parties of mail threads.
+  LinkedOrganizationID int    `json:"linked_organization_id,omitempty"`
+  MessageTime          string `json:"message_time,omitempty"`
+  LatestSent           bool   `json:"latest_sent,omitempty"`
+}
+
+// MailAttachment represents a file attached to a mail message.
+type MailAttachment struct {
+  ID         int    `json:"id"`
+  FileName   string `json:"file_name"`
+  FileType   string `json:"file_type"`
+  FileSize   int    `json:"file_size"`
+  InlineFlag bool   `json:"inline_flag"`
+  Cid        string `json:"cid"`
+  URL        string `json:"url"`
+}
+
+// MailMessage represents a Pipedrive mail message. Flags are 0 or 1.
+type MailMessage struct {
+  ID                          int               `json:"id"`
+  From                        []MailParticipant `json:"from"`
+  To                          []MailParticipant `json:"to"`
+  Cc                          []MailParticipant `json:"cc"`
+  Bcc                         []MailParticipant `json:"bcc"`
+  BodyURL                     string            `json:"body_url"`
+  AccountID                   string            `json:"account_id"`
+  UserID                      int               `json:"user_id"`
+  MailThreadID                int               `json:"mail_thread_id"`
+  Subject                     string            `json:"subject"`
+  Snippet                     string            `json:"snippet"`
+  MailTrackingStatus          string            `json:"mail_tracking_status"`
+  MailLinkTrackingEnabledFlag int               `json:"mail_link_tracking_enabled_flag"`
+  ReadFlag                    int               `json:"read_flag"`
+  Draft                       string            `json:"draft"`
+  DraftFlag                   int               `json:"draft_flag"`
+  SyncedFlag                  int               `json:"synced_flag"`
+  DeletedFlag                 int               `json:"deleted_flag"`
+  HasBodyFlag                 int               `json:"has_body_flag"`
+  SentFlag                    int               `json:"sent_flag"`
+  SentFromPipedriveFlag       int               `json:"sent_from_pipedrive_flag"`
+  SmartBccFlag                int               `json:"smart_bcc_flag"`
+  HasAttachmentsFlag          int               `json:"has_attachments_flag"`
+  HasInlineAttachmentsFlag    int               `json:"has_inline_attachments_flag"`
+  HasRealAttachmentsFlag      int               `json:"has_real_attachments_flag"`
+  DealID                      *int              `json:"deal_id,omitempty"`
+  LeadID                      *string           `json:"lead_id,omitempty"`
+  MessageTime                 string            `json:"message_time"`
+  AddTime                     string            `json:"add_time"`
+  UpdateTime                  string            `json:"update_time"`
+
+  // Set when the message was requested with its body.
+  Body string `json:"body,omitempty"`
+
+  Attachments []MailAttachment `json:"attachments,omitempty"`
+}
+
+func (m MailMessage) String() string {
+  return Stringify(m)
+}
+
+// MailThreadParties represents the participants of a mail thread.
+type MailThreadParties struct {
+  To   []MailParticipant `json:"to"`
+  From []MailParticipant `json:"from"`
+}
+
+// MailThread represents a Pipedrive mail thread, a conversation. Flags
+// are 0 or 1.
+type MailThread struct {
+  ID                           int               `json:"id"`
+  AccountID                    string            `json:"account_id"`
+  UserID                       int               `json:"user_id"`
+  Subject                      string            `json:"subject"`
+  Snippet                      string            `json:"snippet"`
+  SnippetDraft                 string            `json:"snippet_draft"`
+  SnippetSent                  string            `json:"snippet_sent"`
+  Parties                      MailThreadParties `json:"parties"`
+  DraftsParties                []MailParticipant `json:"drafts_parties"`
+  Folders                      []MailFolder      `json:"folders"`
+  MessageCount                 int               `json:"message_count"`
+  ReadFlag                     int               `json:"read_flag"`
+  ArchivedFlag                 int               `json:"archived_flag"`
+  SharedFlag                   int               `json:"shared_flag"`
+  DeletedFlag                  int               `json:"deleted_flag"`
+  SyncedFlag                   int               `json:"synced_flag"`
+  SmartBccFlag                 int               `json:"smart_bcc_flag"`
+  HasDraftFlag                 int               `json:"has_draft_flag"`
+  HasSentFlag                  int               `json:"has_sent_flag"`
+  HasAttachmentsFlag           int               `json:"has_attachments_flag"`
+  HasInlineAttachmentsFlag     int               `json:"has_inline_attachments_flag"`
+  HasRealAttachmentsFlag       int               `json:"has_real_attachments_flag"`
+  AllMessagesSentFlag          int               `json:"all_messages_sent_flag"`
+  MailTrackingStatus           string            `json:"mail_tracking_status"`
+  MailLinkTrackingEnabledFlag  int               `json:"mail_link_tracking_enabled_flag"`
+  FirstMessageTimestamp        string            `json:"first_message_timestamp"`
+  LastMessageTimestamp         string            `json:"last_message_timestamp"`
+  LastMessageSentTimestamp     string            `json:"last_message_sent_timestamp"`
+  LastMessageReceivedTimestamp string            `json:"last_message_received_timestamp"`
+  AddTime                      string            `json:"add_time"`
+  UpdateTime                   string            `json:"update_time"`
+
+  // Deal or lead the thread is linked to.
+  DealID     *int    `json:"deal_id"`
+  DealStatus string  `json:"deal_status"`
+  LeadID     *string `json:"lead_id"`
+}
+
+func (t MailThread) String() string {
+  return Stringify(t)
+}
+
+// MailThreadsResponse represents multiple mail threads response.
+type MailThreadsResponse struct {
+  Success        bool           `json:"success"`
+  Data           []MailThread   `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// MailThreadResponse represents single mail thread response.
+type MailThreadResponse struct {
+  Success bool       `json:"success"`
+  Data    MailThread `json:"data"`
+}
+
+// MailMessagesResponse represents multiple mail messages response.
+type MailMessagesResponse struct {
+  Success        bool           `json:"success"`
+  Data           []MailMessage  `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// MailMessageResponse represents single mail message response.
+type MailMessageResponse struct {
+  Success bool        `json:"success"`
+  Data    MailMessage `json:"data"`
+}
+
+// MailThreadsListOptions specifices the optional parameters to the
+// MailboxService.ListThreads method.
+type MailThreadsListOptions struct {
+  Folder MailFolder `url:"folder"`
+
+  ListOptions
+}
+
+// ListThreads lists the mail threads of a folder.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Mailbox#getMailThreads
+func (s *MailboxService) ListThreads(ctx context.Context, opt *MailThreadsListOptions) (*MailThreadsResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/mailbox/mailThreads", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *MailThreadsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllThreads returns an iterator over all mail threads of a folder.
+func (s *MailboxService) ListAllThreads(ctx context.Context, opt *MailThreadsListOptions) *Iterator[MailThread] {
+  var filters MailThreadsListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]MailThread, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.ListThreads(ctx, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// GetThread returns a specific mail thread.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Mailbox#getMailThread
+func (s *MailboxService) GetThread(ctx context.Context, id int) (*MailThreadResponse, *Response, error) {
+  uri := fmt.Sprintf("/mailbox/mailThreads/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *MailThreadResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListThreadMessages lists the messages of a mail thread, without bodies.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Mailbox#getMailThreadMessages
+func (s *MailboxService) ListThreadMessages(ctx context.Context, id int) (*MailMessagesResponse, *Response, error) {
+  uri := fmt.Sprintf("/mailbox/mailThreads/%v/mailMessages", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *MailMessagesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// MailMessageGetOptions specifices the optional parameters to the
+// MailboxService.GetMessage method.
+type MailMessageGetOptions struct {
+  // Set to 1 to return the body of the message.
+  IncludeBody uint8 `url:"include_body,omitempty"`
+}
+
+// GetMessage returns a specific mail message.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Mailbox#getMailMessage
+func (s *MailboxService) GetMessage(ctx context.Context, id int, opt *MailMessageGetOptions) (*MailMessageResponse, *Response, error) {
+  uri := fmt.Sprintf("/mailbox/mailMessages/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *MailMessageResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListThreadMessagesWithBody lists the messages of a mail thread and
+// fetches the body of each of them, one request per message.
+func (s *MailboxService) ListThreadMessagesWithBody(ctx context.Context, id int) ([]MailMessage, error) {
+  record, _, err := s.ListThreadMessages(ctx, id)
+
+  if err != nil {
+    return nil, err
+  }
+
+  if record == nil {
+    return nil, nil
+  }
+
+  messages := make([]MailMessage, 0, len(record.Data))
+
+  for _, m := range record.Data {
+    message, _, err := s.GetMessage(ctx, m.ID, &MailMessageGetOptions{IncludeBody: 1})
+
+    if err != nil {
+      return messages, err
+    }
+
+    if message == nil {
+      return messages, fmt.Errorf("mail message %v not found", m.ID)
+    }
+
+    messages = append(messages, message.Data)
+  }
+
+  return messages, nil
+}
+
+// MailThreadUpdateOptions specifices the optional parameters to the
+// MailboxService.UpdateThread method. Flags are 0 or 1, nil leaves them
+// unchanged.
+type MailThreadUpdateOptions struct {
+  DealID       *int    `json:"deal_id,omitempty"`
+  LeadID       *string `json:"lead_id,omitempty"`
+  SharedFlag   *uint8  `json:"shared_flag,omitempty"`
+  ReadFlag     *uint8  `json:"read_flag,omitempty"`
+  ArchivedFlag *uint8  `json:"archived_flag,omitempty"`
+}
+
+// UpdateThread updates the flags of a mail thread or links it to a deal
+// or lead.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Mailbox#updateMailThreadDetails
+func (s *MailboxService) UpdateThread(ctx context.Context, id int, opt *MailThreadUpdateOptions) (*MailThreadResponse, *Response, error) {
+  uri := fmt.Sprintf("/mailbox/mailThreads/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *MailThreadResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// DeleteThread marks a mail thread as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Mailbox#deleteMailThread
+func (s *MailboxService) DeleteThread(ctx context.Context, id int) (*Response, error) {
+  uri := fmt.Sprintf("/mailbox/mailThreads/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
+
+  if err != nil {
+    return nil, err
+  }
+
+  return s.client.Do(ctx, req, nil)
+}
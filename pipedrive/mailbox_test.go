@@ -0,0 +1,85 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "testing"
+)
+
+func TestMailboxService_ListThreads(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/mailbox/mailThreads", func(w http.ResponseWriter, r *http.Request) {
+    if got := r.URL.Query().Get("folder"); got != "archive" {
+      t.Errorf("folder = %q, want archive", got)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":[{"id":1,"subject":"Renewal","deal_id":7,"folders":["archive"],
+      "parties":{"from":[{"id":2,"email_address":"jane@example.com","linked_person_id":9}],"to":[]}}]}`)
+  })
+
+  record, _, err := client.Mailbox.ListThreads(context.Background(), &MailThreadsListOptions{Folder: MailFolderArchive})
+
+  if err != nil {
+    t.Fatalf("ListThreads returned error: %v", err)
+  }
+
+  thread := record.Data[0]
+
+  if thread.DealID == nil || *thread.DealID != 7 || thread.Parties.From[0].LinkedPersonID != 9 {
+    t.Errorf("ListThreads returned %+v", thread)
+  }
+}
+
+func TestMailboxService_UpdateThread(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/mailbox/mailThreads/1", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodPut {
+      t.Errorf("method = %v, want PUT", r.Method)
+    }
+
+    var body map[string]interface{}
+    json.NewDecoder(r.Body).Decode(&body)
+
+    if len(body) != 2 || body["deal_id"] != 7.0 || body["read_flag"] != 0.0 {
+      t.Errorf("body = %v", body)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":{"id":1,"deal_id":7}}`)
+  })
+
+  dealID, read := 7, uint8(0)
+
+  _, _, err := client.Mailbox.UpdateThread(context.Background(), 1, &MailThreadUpdateOptions{DealID: &dealID, ReadFlag: &read})
+
+  if err != nil {
+    t.Fatalf("UpdateThread returned error: %v", err)
+  }
+}
+
+func TestMailboxService_ListThreadMessagesWithBody_emptyMessage(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/mailbox/mailThreads/1/mailMessages", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":[{"id":2},{"id":3}]}`)
+  })
+
+  mux.HandleFunc("/mailbox/mailMessages/2", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":{"id":2,"body":"Hello"}}`)
+  })
+
+  mux.HandleFunc("/mailbox/mailMessages/3", func(w http.ResponseWriter, r *http.Request) {})
+
+  messages, err := client.Mailbox.ListThreadMessagesWithBody(context.Background(), 1)
+
+  if err == nil {
+    t.Fatal("ListThreadMessagesWithBody returned no error for an empty message")
+  }
+
+  if len(messages) != 1 || messages[0].ID != 2 {
+    t.Errorf("ListThreadMessagesWithBody returned %+v", messages)
+  }
+}
@@ -0,0 +1,161 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "io"
+  "mime"
+  "mime/multipart"
+  "net/http"
+  "net/textproto"
+  "net/url"
+  "path/filepath"
+  "sort"
+  "strings"
+  "sync"
+)
+
+// MultipartFile is a file streamed in a multipart request.
+type MultipartFile struct {
+  // Name of the form field holding the file.
+  FieldName string
+
+  // Name of the file, as seen by Pipedrive.
+  FileName string
+
+  // Content type of the file. Detected from the file name extension when
+  // empty, falling back to application/octet-stream.
+  ContentType string
+
+  Reader io.Reader
+}
+
+func (f *MultipartFile) contentType() string {
+  if f.ContentType != "" {
+    return f.ContentType
+  }
+
+  if t := mime.TypeByExtension(filepath.Ext(f.FileName)); t != "" {
+    return t
+  }
+
+  return "application/octet-stream"
+}
+
+// NewMultipartRequestWithContext creates an API request sending fields and
+// file as multipart/form-data. The file is streamed while the request is
+// sent rather than buffered in memory, so the request can not be retried.
+func (c *Client) NewMultipartRequestWithContext(ctx context.Context, method, url string, opt interface{}, fields url.Values, file *MultipartFile) (*http.Request, error) {
+  if file == nil || file.Reader == nil {
+    return nil, fmt.Errorf("multipart request to %v has no file", url)
+  }
+
+  if !strings.HasSuffix(c.BaseURL.Path, "/") && !c.useProxy {
+    return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
+  }
+
+  u, err := c.createRequestUrl(url, opt)
+
+  if err != nil {
+    return nil, err
+  }
+
+  body := newMultipartBody(fields, file)
+
+  request, err := http.NewRequestWithContext(ctx, method, u, body)
+
+  if err != nil {
+    return nil, err
+  }
+
+  if c.useProxy {
+    request.Header.Set("Authorization", "Bearer " + c.accessToken)
+  }
+
+  if c.UserAgent != "" {
+    request.Header.Set("User-Agent", c.UserAgent)
+  }
+
+  request.Header.Set("Content-Type", body.writer.FormDataContentType())
+
+  return request, nil
+}
+
+// multipartBody encodes a multipart form through a pipe. Encoding starts
+// on the first read, so nothing leaks when the request is never sent.
+type multipartBody struct {
+  reader *io.PipeReader
+  pipe   *io.PipeWriter
+  writer *multipart.Writer
+  start  sync.Once
+
+  fields url.Values
+  file   *MultipartFile
+}
+
+func newMultipartBody(fields url.Values, file *MultipartFile) *multipartBody {
+  reader, pipe := io.Pipe()
+
+  return &multipartBody{
+    reader: reader,
+    pipe:   pipe,
+    writer: multipart.NewWriter(pipe),
+    fields: fields,
+    file:   file,
+  }
+}
+
+func (b *multipartBody) Read(p []byte) (int, error) {
+  b.start.Do(func() {
+    go func() {
+      b.pipe.CloseWithError(b.encode())
+    }()
+  })
+
+  return b.reader.Read(p)
+}
+
+func (b *multipartBody) Close() error {
+  return b.reader.Close()
+}
+
+func (b *multipartBody) encode() error {
+  keys := make([]string, 0, len(b.fields))
+
+  for key := range b.fields {
+    keys = append(keys, key)
+  }
+
+  sort.Strings(keys)
+
+  for _, key := range keys {
+    for _, value := range b.fields[key] {
+      if err := b.writer.WriteField(key, value); err != nil {
+        return err
+      }
+    }
+  }
+
+  header := make(textproto.MIMEHeader)
+  header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+    escapeQuotes(b.file.FieldName), escapeQuotes(b.file.FileName)))
+  header.Set("Content-Type", b.file.contentType())
+
+  part, err := b.writer.CreatePart(header)
+
+  if err != nil {
+    return err
+  }
+
+  if _, err := io.Copy(part, b.file.Reader); err != nil {
+    return err
+  }
+
+  return b.writer.Close()
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+  return quoteEscaper.Replace(s)
+}
@@ -45,7 +45,7 @@ type NoteFieldsResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/NoteFields/get_noteFields
 func (s *NoteFieldsService) List(ctx context.Context) (*NoteFieldsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/noteFields", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/noteFields", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -50,7 +50,7 @@ type NoteResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Notes/get_notes
 func (s *NotesService) List(ctx context.Context) (*NotesResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/notes", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/notes", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -67,12 +67,33 @@ func (s *NotesService) List(ctx context.Context) (*NotesResponse, *Response, err
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all notes.
+func (s *NotesService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[Note] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Note, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/notes", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *NotesResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // GetByID returns a specific note by id.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Notes/get_notes_id
 func (s *NotesService) GetByID(ctx context.Context, id int) (*NoteResponse, *Response, error) {
   uri := fmt.Sprintf("/notes/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -105,7 +126,7 @@ type NoteCreateOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Notes/get_notes_id
 func (s *NotesService) Create(ctx context.Context, opt *NoteCreateOptions) (*NoteResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/notes", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/notes", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -139,7 +160,7 @@ type NoteUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Notes/put_notes_id
 func (s *NotesService) Update(ctx context.Context, id int, opt *NoteUpdateOptions) (*NoteResponse, *Response, error) {
   uri := fmt.Sprintf("/notes/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -161,7 +182,7 @@ func (s *NotesService) Update(ctx context.Context, id int, opt *NoteUpdateOption
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Notes/delete_notes_id
 func (s *NotesService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/notes/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -0,0 +1,334 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "errors"
+  "fmt"
+  "io/ioutil"
+  "net/http"
+  "net/url"
+  "strings"
+  "sync"
+  "time"
+)
+
+const (
+  defaultOAuthAuthURL = "https://oauth.pipedrive.com/oauth/authorize"
+
+  defaultOAuthTokenURL = "https://oauth.pipedrive.com/oauth/token"
+
+  // Tokens are refreshed this long before they expire.
+  tokenExpiryDelta = time.Minute
+)
+
+// OAuthConfig describes a Pipedrive OAuth 2.0 application.
+//
+// Pipedrive OAuth docs: https://pipedrive.readme.io/docs/marketplace-oauth-authorization
+type OAuthConfig struct {
+  ClientID     string
+  ClientSecret string
+  RedirectURL  string
+
+  // Endpoints of the authorization server. Default to the Pipedrive ones.
+  AuthURL  string
+  TokenURL string
+
+  // HTTP client used to call the token endpoint. Defaults to http.DefaultClient.
+  HTTPClient *http.Client
+}
+
+// Token represents an OAuth 2.0 access token with its refresh token.
+type Token struct {
+  AccessToken  string    `json:"access_token"`
+  TokenType    string    `json:"token_type,omitempty"`
+  RefreshToken string    `json:"refresh_token,omitempty"`
+  Scope        string    `json:"scope,omitempty"`
+  ExpiresIn    int       `json:"expires_in,omitempty"`
+  APIDomain    string    `json:"api_domain,omitempty"`
+  Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+func (t Token) String() string {
+  return Stringify(t)
+}
+
+// Valid reports whether the token is set and does not expire soon.
+func (t *Token) Valid() bool {
+  if t == nil || t.AccessToken == "" {
+    return false
+  }
+
+  return t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry)
+}
+
+// OAuthError reports an error returned by the token endpoint.
+type OAuthError struct {
+  Response    *http.Response
+  Code        string `json:"error"`
+  Description string `json:"error_description"`
+  Message     string `json:"message"`
+  Body        []byte `json:"-"`
+}
+
+func (e *OAuthError) Error() string {
+  description := e.Description
+
+  if description == "" {
+    description = e.Message
+  }
+
+  return fmt.Sprintf("oauth token request: %d %v %v",
+    e.Response.StatusCode, e.Code, description)
+}
+
+// Is reports whether target is ErrUnauthorized.
+func (e *OAuthError) Is(target error) bool {
+  return target == ErrUnauthorized
+}
+
+// AuthCodeURL returns the URL users are sent to for approving the app.
+func (c *OAuthConfig) AuthCodeURL(state string) string {
+  authURL := c.AuthURL
+
+  if authURL == "" {
+    authURL = defaultOAuthAuthURL
+  }
+
+  v := url.Values{}
+  v.Set("client_id", c.ClientID)
+  v.Set("redirect_uri", c.RedirectURL)
+
+  if state != "" {
+    v.Set("state", state)
+  }
+
+  if strings.Contains(authURL, "?") {
+    return authURL + "&" + v.Encode()
+  }
+
+  return authURL + "?" + v.Encode()
+}
+
+// Exchange converts an authorization code into a token.
+func (c *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+  return c.requestToken(ctx, url.Values{
+    "grant_type":   {"authorization_code"},
+    "code":         {code},
+    "redirect_uri": {c.RedirectURL},
+  })
+}
+
+// Refresh obtains a new token using a refresh token.
+func (c *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+  if refreshToken == "" {
+    return nil, errors.New("oauth: refresh token is empty")
+  }
+
+  token, err := c.requestToken(ctx, url.Values{
+    "grant_type":    {"refresh_token"},
+    "refresh_token": {refreshToken},
+  })
+
+  if err != nil {
+    return nil, err
+  }
+
+  if token.RefreshToken == "" {
+    token.RefreshToken = refreshToken
+  }
+
+  return token, nil
+}
+
+func (c *OAuthConfig) requestToken(ctx context.Context, form url.Values) (*Token, error) {
+  tokenURL := c.TokenURL
+
+  if tokenURL == "" {
+    tokenURL = defaultOAuthTokenURL
+  }
+
+  req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+
+  if err != nil {
+    return nil, err
+  }
+
+  req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+  req.SetBasicAuth(c.ClientID, c.ClientSecret)
+
+  httpClient := c.HTTPClient
+
+  if httpClient == nil {
+    httpClient = http.DefaultClient
+  }
+
+  resp, err := httpClient.Do(req)
+
+  if err != nil {
+    return nil, err
+  }
+
+  defer resp.Body.Close()
+
+  data, err := ioutil.ReadAll(resp.Body)
+
+  if err != nil {
+    return nil, err
+  }
+
+  if resp.StatusCode < 200 || resp.StatusCode > 299 {
+    oauthErr := &OAuthError{Response: resp, Body: data}
+    json.Unmarshal(data, oauthErr)
+
+    return nil, oauthErr
+  }
+
+  var token *Token
+
+  if err := json.Unmarshal(data, &token); err != nil {
+    return nil, newDecodeError(resp, err, data)
+  }
+
+  if token == nil || token.AccessToken == "" {
+    return nil, newDecodeError(resp, errors.New("access_token missing"), data)
+  }
+
+  if token.ExpiresIn > 0 {
+    token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+  }
+
+  return token, nil
+}
+
+// WithOAuth authenticates requests with OAuth tokens kept in store.
+// Tokens are refreshed when they expire or a request is rejected with
+// 401 Unauthorized, and saved back to the store.
+func WithOAuth(config *OAuthConfig, store TokenStore) func(*Client) error {
+  return func(c *Client) error {
+    if config == nil || config.ClientID == "" {
+      return errors.New("OAuth config must have a client ID")
+    }
+
+    if store == nil {
+      return errors.New("OAuth token store must not be nil")
+    }
+
+    c.oauth = &oauthTokenSource{
+      config: config,
+      store:  store,
+    }
+
+    return nil
+  }
+}
+
+// SetOAuthToken replaces the token used by the client, for example after
+// exchanging an authorization code, and saves it to the token store.
+func (c *Client) SetOAuthToken(ctx context.Context, token *Token) error {
+  if c.oauth == nil {
+    return errors.New("OAuth is not configured")
+  }
+
+  return c.oauth.set(ctx, token)
+}
+
+// OAuthToken returns a valid token, refreshing it if needed.
+func (c *Client) OAuthToken(ctx context.Context) (*Token, error) {
+  if c.oauth == nil {
+    return nil, errors.New("OAuth is not configured")
+  }
+
+  return c.oauth.token(ctx)
+}
+
+// oauthTokenSource hands out the current token and swaps in refreshed ones.
+type oauthTokenSource struct {
+  config *OAuthConfig
+  store  TokenStore
+
+  mu      sync.Mutex
+  current *Token
+}
+
+func (s *oauthTokenSource) set(ctx context.Context, token *Token) error {
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  if err := s.store.Save(ctx, token); err != nil {
+    return err
+  }
+
+  s.current = token
+
+  return nil
+}
+
+// token returns a valid token, reloading it from the store or refreshing
+// it when the current one expired.
+func (s *oauthTokenSource) token(ctx context.Context) (*Token, error) {
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  if s.current.Valid() {
+    return s.current, nil
+  }
+
+  return s.refreshLocked(ctx, "")
+}
+
+// refresh replaces a token rejected by the API. Nothing is refreshed when
+// another goroutine already swapped the rejected token.
+func (s *oauthTokenSource) refresh(ctx context.Context, rejected string) (*Token, error) {
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  if s.current != nil && s.current.AccessToken != rejected && s.current.Valid() {
+    return s.current, nil
+  }
+
+  return s.refreshLocked(ctx, rejected)
+}
+
+func (s *oauthTokenSource) refreshLocked(ctx context.Context, rejected string) (*Token, error) {
+  // Another instance sharing the store may already have refreshed it.
+  stored, err := s.store.Load(ctx)
+
+  if err != nil && !errors.Is(err, ErrTokenNotFound) {
+    return nil, err
+  }
+
+  if stored.Valid() && stored.AccessToken != rejected {
+    s.current = stored
+
+    return stored, nil
+  }
+
+  refreshToken := ""
+
+  if stored != nil {
+    refreshToken = stored.RefreshToken
+  }
+
+  if refreshToken == "" && s.current != nil {
+    refreshToken = s.current.RefreshToken
+  }
+
+  if refreshToken == "" {
+    return nil, errors.New("oauth: no token available, complete the authorization flow first")
+  }
+
+  token, err := s.config.Refresh(ctx, refreshToken)
+
+  if err != nil {
+    return nil, err
+  }
+
+  if err := s.store.Save(ctx, token); err != nil {
+    return nil, err
+  }
+
+  s.current = token
+
+  return token, nil
+}
@@ -0,0 +1,195 @@
+package pipedrive
+
+import (
+  "context"
+  "errors"
+  "fmt"
+  "io"
+  "net/http"
+  "net/http/httptest"
+  "path/filepath"
+  "strings"
+  "sync/atomic"
+  "testing"
+  "time"
+)
+
+// fakeTokenServer issues access tokens "token-1", "token-2", ...
+func fakeTokenServer(t *testing.T) (*OAuthConfig, *int32) {
+  t.Helper()
+
+  var issued int32
+
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    if user, pass, ok := r.BasicAuth(); !ok || user != "id" || pass != "secret" {
+      w.WriteHeader(http.StatusUnauthorized)
+      w.Write([]byte(`{"error":"invalid_client"}`))
+      return
+    }
+
+    r.ParseForm()
+
+    switch r.Form.Get("grant_type") {
+    case "authorization_code":
+      if r.Form.Get("code") != "the-code" {
+        w.WriteHeader(http.StatusBadRequest)
+        w.Write([]byte(`{"error":"invalid_grant"}`))
+        return
+      }
+    case "refresh_token":
+      if r.Form.Get("refresh_token") != "refresh" {
+        w.WriteHeader(http.StatusBadRequest)
+        w.Write([]byte(`{"error":"invalid_grant"}`))
+        return
+      }
+    }
+
+    n := atomic.AddInt32(&issued, 1)
+    fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","refresh_token":"refresh","expires_in":3599,"api_domain":"https://acme.pipedrive.com"}`, n)
+  }))
+
+  t.Cleanup(server.Close)
+
+  return &OAuthConfig{
+    ClientID:     "id",
+    ClientSecret: "secret",
+    RedirectURL:  "https://example.com/callback",
+    TokenURL:     server.URL,
+  }, &issued
+}
+
+func TestOAuthConfig_AuthCodeURL(t *testing.T) {
+  config := &OAuthConfig{ClientID: "id", RedirectURL: "https://example.com/callback"}
+  got := config.AuthCodeURL("xyz")
+
+  if !strings.HasPrefix(got, defaultOAuthAuthURL+"?") || !strings.Contains(got, "state=xyz") || !strings.Contains(got, "client_id=id") {
+    t.Errorf("AuthCodeURL = %v", got)
+  }
+}
+
+func TestOAuthConfig_Exchange(t *testing.T) {
+  config, _ := fakeTokenServer(t)
+
+  token, err := config.Exchange(context.Background(), "the-code")
+
+  if err != nil {
+    t.Fatalf("Exchange returned error: %v", err)
+  }
+
+  if token.AccessToken != "token-1" || token.RefreshToken != "refresh" || !token.Valid() {
+    t.Errorf("Exchange returned %+v", token)
+  }
+
+  if _, err := config.Exchange(context.Background(), "wrong"); err == nil {
+    t.Error("Exchange accepted an invalid code")
+  }
+}
+
+func TestClient_refreshesRejectedToken(t *testing.T) {
+  config, issued := fakeTokenServer(t)
+  client, mux, _ := setup(t)
+
+  store := NewMemoryTokenStore(&Token{AccessToken: "stale", RefreshToken: "refresh"})
+
+  if err := client.SetOptions(WithOAuth(config, store)); err != nil {
+    t.Fatal(err)
+  }
+
+  mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+    if r.URL.Query().Get("api_token") != "" {
+      t.Error("api_token sent with OAuth")
+    }
+
+    if r.Header.Get("Authorization") != "Bearer token-1" {
+      w.WriteHeader(http.StatusUnauthorized)
+      w.Write([]byte(`{"success":false,"error":"unauthorized access"}`))
+      return
+    }
+
+    w.Write([]byte(`{"success":true}`))
+  })
+
+  if _, _, err := client.Users.GetCurrentUserData(context.Background()); err != nil {
+    t.Fatalf("GetCurrentUserData returned error: %v", err)
+  }
+
+  if *issued != 1 {
+    t.Errorf("Issued %d tokens, want 1", *issued)
+  }
+
+  stored, _ := store.Load(context.Background())
+
+  if stored.AccessToken != "token-1" {
+    t.Errorf("Stored token = %v, want token-1", stored.AccessToken)
+  }
+}
+
+func TestClient_refreshesExpiredToken(t *testing.T) {
+  config, issued := fakeTokenServer(t)
+  client, mux, _ := setup(t)
+
+  store := NewFileTokenStore(filepath.Join(t.TempDir(), "token.json"))
+  store.Save(context.Background(), &Token{AccessToken: "old", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Hour)})
+
+  if err := client.SetOptions(WithOAuth(config, store)); err != nil {
+    t.Fatal(err)
+  }
+
+  mux.HandleFunc("/users/me", func(w http.ResponseWriter, r *http.Request) {
+    if got := r.Header.Get("Authorization"); got != "Bearer token-1" {
+      t.Errorf("Authorization = %q", got)
+    }
+
+    w.Write([]byte(`{"success":true}`))
+  })
+
+  if _, _, err := client.Users.GetCurrentUserData(context.Background()); err != nil {
+    t.Fatalf("GetCurrentUserData returned error: %v", err)
+  }
+
+  if *issued != 1 {
+    t.Errorf("Issued %d tokens, want 1", *issued)
+  }
+
+  stored, err := store.Load(context.Background())
+
+  if err != nil || stored.AccessToken != "token-1" {
+    t.Errorf("Stored token = %+v, %v", stored, err)
+  }
+}
+
+func TestClient_keepsRejectedStreamedRequest(t *testing.T) {
+  config, issued := fakeTokenServer(t)
+  client, mux, serverURL := setup(t)
+
+  store := NewMemoryTokenStore(&Token{AccessToken: "stale", RefreshToken: "refresh"})
+
+  if err := client.SetOptions(WithOAuth(config, store)); err != nil {
+    t.Fatal(err)
+  }
+
+  var requests int32
+
+  mux.HandleFunc("/deals", func(w http.ResponseWriter, r *http.Request) {
+    atomic.AddInt32(&requests, 1)
+    w.WriteHeader(http.StatusUnauthorized)
+    w.Write([]byte(`{"success":false,"error":"unauthorized access"}`))
+  })
+
+  // The body of a streamed request is consumed by the first attempt and
+  // can not be sent again with a refreshed token.
+  body := io.MultiReader(strings.NewReader(`{"title":"Deal"}`))
+  req, _ := http.NewRequest(http.MethodPost, serverURL+"/deals", body)
+
+  _, err := client.Do(context.Background(), req, nil)
+
+  var authErr *AuthError
+
+  if !errors.As(err, &authErr) {
+    t.Errorf("Do returned %v, want AuthError", err)
+  }
+
+  if requests != 1 || *issued != 0 {
+    t.Errorf("Sent %d requests and issued %d tokens, want 1 and 0", requests, *issued)
+  }
+}
@@ -0,0 +1,103 @@
+package pipedrive
+
+import (
+  "errors"
+  "fmt"
+  "net/http"
+  "net/url"
+  "strings"
+  "time"
+)
+
+// WithHTTPClient sets the HTTP client used to communicate with the API.
+func WithHTTPClient(httpClient *http.Client) func(*Client) error {
+  return func(c *Client) error {
+    if httpClient == nil {
+      return errors.New("HTTP client must not be nil")
+    }
+
+    c.client = httpClient
+
+    return nil
+  }
+}
+
+// WithBaseURL sets the API endpoint requests are sent to, for example
+// a local stub server. A trailing slash is added when missing.
+func WithBaseURL(rawURL string) func(*Client) error {
+  return func(c *Client) error {
+    baseURL, err := url.Parse(rawURL)
+
+    if err != nil {
+      return fmt.Errorf("invalid base URL %q: %v", rawURL, err)
+    }
+
+    if baseURL.Scheme == "" || baseURL.Host == "" {
+      return fmt.Errorf("base URL %q must be absolute", rawURL)
+    }
+
+    if !strings.HasSuffix(baseURL.Path, "/") {
+      baseURL.Path += "/"
+    }
+
+    c.BaseURL = baseURL
+
+    return nil
+  }
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) func(*Client) error {
+  return func(c *Client) error {
+    if strings.TrimSpace(userAgent) == "" {
+      return errors.New("user agent must not be empty")
+    }
+
+    c.UserAgent = userAgent
+
+    return nil
+  }
+}
+
+// WithCompanyDomain sends requests to the API endpoint of a company,
+// e.g. "acme" for https://acme.pipedrive.com/v1/.
+func WithCompanyDomain(domain string) func(*Client) error {
+  return func(c *Client) error {
+    baseURL, err := companyDomainURL(domain)
+
+    if err != nil {
+      return err
+    }
+
+    c.BaseURL = baseURL
+
+    return nil
+  }
+}
+
+// WithTimeout sets the time limit for requests made by the client. The
+// HTTP client is copied, so it is safe to use with a shared client.
+func WithTimeout(timeout time.Duration) func(*Client) error {
+  return func(c *Client) error {
+    if timeout <= 0 {
+      return errors.New("timeout must be positive")
+    }
+
+    httpClient := *c.client
+    httpClient.Timeout = timeout
+    c.client = &httpClient
+
+    return nil
+  }
+}
+
+// companyDomainURL returns the API endpoint of a company domain.
+func companyDomainURL(domain string) (*url.URL, error) {
+  domain = strings.TrimSuffix(strings.TrimSpace(domain), "."+companyDomainHost)
+
+  if domain == "" || strings.ContainsAny(domain, "./:") {
+    return nil, fmt.Errorf("invalid company domain %q", domain)
+  }
+
+  return url.Parse(hostProtocol + "://" + domain + "." + companyDomainHost + "/v" + libraryVersion + "/")
+}
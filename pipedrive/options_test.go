@@ -0,0 +1,106 @@
+package pipedrive
+
+import (
+  "context"
+  "net/http"
+  "testing"
+  "time"
+)
+
+func TestWithBaseURL_usedByRequests(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/deals/1", func(w http.ResponseWriter, r *http.Request) {
+    if got := r.URL.Query().Get("api_token"); got != "test-token" {
+      t.Errorf("api_token = %q, want test-token", got)
+    }
+
+    if got := r.Header.Get("User-Agent"); got != "tests/1.0" {
+      t.Errorf("User-Agent = %q, want tests/1.0", got)
+    }
+
+    w.Write([]byte(`{"success":true,"data":{"id":1}}`))
+  })
+
+  if err := client.SetOptions(WithUserAgent("tests/1.0")); err != nil {
+    t.Fatal(err)
+  }
+
+  req, err := client.NewRequest(http.MethodGet, "/deals/1", nil, nil)
+
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  var record *DealResponse
+
+  if _, err := client.Do(context.Background(), req, &record); err != nil {
+    t.Fatalf("Do returned error: %v", err)
+  }
+
+  if record.Data.ID != 1 {
+    t.Errorf("Deal ID = %v, want 1", record.Data.ID)
+  }
+}
+
+func TestWithCompanyDomain(t *testing.T) {
+  client := NewClient(&Config{CompanyDomain: "acme"})
+
+  if got, want := client.BaseURL.String(), "https://acme.pipedrive.com/v1/"; got != want {
+    t.Errorf("BaseURL = %v, want %v", got, want)
+  }
+
+  if err := client.SetOptions(WithCompanyDomain("bad/domain")); err == nil {
+    t.Error("WithCompanyDomain accepted an invalid domain")
+  }
+}
+
+func TestNewValidatedClient(t *testing.T) {
+  if _, err := NewValidatedClient(&Config{CompanyDomain: "bad/domain"}); err == nil {
+    t.Error("NewValidatedClient accepted an invalid domain")
+  }
+
+  client, err := NewValidatedClient(&Config{CompanyDomain: "acme"})
+
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if got, want := client.BaseURL.String(), "https://acme.pipedrive.com/v1/"; got != want {
+    t.Errorf("BaseURL = %v, want %v", got, want)
+  }
+}
+
+func TestSetOptions_validation(t *testing.T) {
+  client := NewClient(&Config{})
+
+  tests := []func(*Client) error{
+    WithHTTPClient(nil),
+    WithBaseURL("/relative"),
+    WithUserAgent(" "),
+    WithTimeout(0),
+  }
+
+  for i, opt := range tests {
+    if err := client.SetOptions(opt); err == nil {
+      t.Errorf("option %d: SetOptions returned no error", i)
+    }
+  }
+}
+
+func TestWithTimeout_copiesHTTPClient(t *testing.T) {
+  shared := &http.Client{}
+  client := NewClient(&Config{})
+
+  if err := client.SetOptions(WithHTTPClient(shared), WithTimeout(time.Second)); err != nil {
+    t.Fatal(err)
+  }
+
+  if shared.Timeout != 0 {
+    t.Error("WithTimeout modified the shared HTTP client")
+  }
+
+  if client.client.Timeout != time.Second {
+    t.Errorf("Timeout = %v, want 1s", client.client.Timeout)
+  }
+}
@@ -34,10 +34,7 @@ type OrganizationField struct {
   Link               string      `json:"link,omitempty"`
   MandatoryFlag      bool        `json:"mandatory_flag"`
   DisplayField       string      `json:"display_field,omitempty"`
-  Options            []struct {
-    ID    int    `json:"id"`
-    Label string `json:"label"`
-  } `json:"options,omitempty"`
+  Options            []FieldOption `json:"options,omitempty"`
   IsSubfield bool `json:"is_subfield,omitempty"`
 }
 
@@ -63,7 +60,7 @@ type OrganizationFieldResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/OrganizationFields/get_organizationFields
 func (s *OrganizationFieldsService) List(ctx context.Context) (*OrganizationFieldsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/organizationFields", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/organizationFields", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -80,12 +77,33 @@ func (s *OrganizationFieldsService) List(ctx context.Context) (*OrganizationFiel
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all organization fields.
+func (s *OrganizationFieldsService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[OrganizationField] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]OrganizationField, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/organizationFields", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *OrganizationFieldsResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // GetByID returns a specific organization field.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/OrganizationFields/get_organizationFields_id
 func (s *OrganizationFieldsService) GetByID(ctx context.Context, id int) (*OrganizationFieldResponse, *Response, error) {
   uri := fmt.Sprintf("/organizationFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -114,7 +132,7 @@ type OrganizationFieldCreateOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/OrganizationFields/post_organizationFields
 func (s *OrganizationFieldsService) Create(ctx context.Context, opt *OrganizationFieldCreateOptions) (*OrganizationFieldResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/organizationFields", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/organizationFields", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -143,7 +161,7 @@ type OrganizationFieldUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/OrganizationFields/put_organizationFields_id
 func (s *OrganizationFieldsService) Update(ctx context.Context, id int, opt *OrganizationFieldUpdateOptions) (*OrganizationFieldResponse, *Response, error) {
   uri := fmt.Sprintf("/organizationFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -164,7 +182,7 @@ func (s *OrganizationFieldsService) Update(ctx context.Context, id int, opt *Org
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/OrganizationFields/delete_organizationFields
 func (s *OrganizationFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/organizationFields", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/organizationFields", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -180,7 +198,7 @@ func (s *OrganizationFieldsService) DeleteMultiple(ctx context.Context, ids []in
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/OrganizationFields/delete_organizationFields_id
 func (s *OrganizationFieldsService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/organizationFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
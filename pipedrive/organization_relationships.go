@@ -0,0 +1,343 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+)
+
+// OrganizationRelationshipsService handles organization relationships related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships
+type OrganizationRelationshipsService service
+
+// OrganizationRelationshipType is the type of a relationship between two
+// organizations.
+type OrganizationRelationshipType string
+
+const (
+  // The owner organization is the parent of the linked one.
+  OrganizationRelationshipParent OrganizationRelationshipType = "parent"
+
+  // The organizations are related without hierarchy.
+  OrganizationRelationshipRelated OrganizationRelationshipType = "related"
+)
+
+// OrganizationRelationship represents a link between two organizations.
+type OrganizationRelationship struct {
+  ID             int                          `json:"id"`
+  Type           OrganizationRelationshipType `json:"type"`
+  RelOwnerOrgID  OrgID                        `json:"rel_owner_org_id"`
+  RelLinkedOrgID OrgID                        `json:"rel_linked_org_id"`
+  AddTime        string                       `json:"add_time"`
+  UpdateTime     string                       `json:"update_time"`
+  ActiveFlag     bool                         `json:"active_flag"`
+
+  // Type of the relationship seen from the organization the
+  // relationships were listed for: "parent", "daughter" or "related".
+  CalculatedType          string `json:"calculated_type"`
+  CalculatedRelatedOrgID  int    `json:"calculated_related_org_id"`
+  RelatedOrganizationName string `json:"related_organization_name"`
+}
+
+func (r OrganizationRelationship) String() string {
+  return Stringify(r)
+}
+
+// OrganizationRelationshipsResponse represents multiple organization
+// relationships response.
+type OrganizationRelationshipsResponse struct {
+  Success        bool                       `json:"success"`
+  Data           []OrganizationRelationship `json:"data"`
+  AdditionalData AdditionalData             `json:"additional_data"`
+}
+
+// OrganizationRelationshipResponse represents single organization
+// relationship response.
+type OrganizationRelationshipResponse struct {
+  Success bool                     `json:"success"`
+  Data    OrganizationRelationship `json:"data"`
+}
+
+// List the relationships of an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#getOrganizationRelationships
+func (s *OrganizationRelationshipsService) List(ctx context.Context, orgID int) (*OrganizationRelationshipsResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/organizationRelationships", struct {
+    OrgID int `url:"org_id"`
+  }{
+    orgID,
+  }, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *OrganizationRelationshipsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Get a specific organization relationship.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#getOrganizationRelationship
+func (s *OrganizationRelationshipsService) Get(ctx context.Context, id int) (*OrganizationRelationshipResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizationRelationships/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *OrganizationRelationshipResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// OrganizationRelationshipOptions specifices the parameters to the
+// OrganizationRelationshipsService.Create and
+// OrganizationRelationshipsService.Update methods.
+type OrganizationRelationshipOptions struct {
+  // Organization the relationship is seen from in the response.
+  OrgID          int                          `json:"org_id,omitempty"`
+  Type           OrganizationRelationshipType `json:"type,omitempty"`
+  RelOwnerOrgID  int                          `json:"rel_owner_org_id,omitempty"`
+  RelLinkedOrgID int                          `json:"rel_linked_org_id,omitempty"`
+}
+
+// Create a relationship between two organizations.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#addOrganizationRelationship
+func (s *OrganizationRelationshipsService) Create(ctx context.Context, opt *OrganizationRelationshipOptions) (*OrganizationRelationshipResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/organizationRelationships", nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *OrganizationRelationshipResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Update an organization relationship.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#updateOrganizationRelationship
+func (s *OrganizationRelationshipsService) Update(ctx context.Context, id int, opt *OrganizationRelationshipOptions) (*OrganizationRelationshipResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizationRelationships/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *OrganizationRelationshipResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Delete an organization relationship.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/OrganizationRelationships#deleteOrganizationRelationship
+func (s *OrganizationRelationshipsService) Delete(ctx context.Context, id int) (*Response, error) {
+  uri := fmt.Sprintf("/organizationRelationships/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
+
+  if err != nil {
+    return nil, err
+  }
+
+  return s.client.Do(ctx, req, nil)
+}
+
+// OrganizationNode is an organization in a hierarchy built by
+// OrganizationRelationshipsService.Hierarchy.
+type OrganizationNode struct {
+  ID       int
+  Name     string
+  Parent   *OrganizationNode
+  Children []*OrganizationNode
+
+  // IDs of organizations linked with a "related" relationship.
+  Related []int
+}
+
+// Walk calls fn for the node and its descendants, depth first. Walking
+// stops at the first error.
+func (n *OrganizationNode) Walk(fn func(node *OrganizationNode, depth int) error) error {
+  return n.walk(fn, 0)
+}
+
+func (n *OrganizationNode) walk(fn func(*OrganizationNode, int) error, depth int) error {
+  if err := fn(n, depth); err != nil {
+    return err
+  }
+
+  for _, child := range n.Children {
+    if err := child.walk(fn, depth+1); err != nil {
+      return err
+    }
+  }
+
+  return nil
+}
+
+// Sum adds up value for the node and all its descendants, e.g. to roll up
+// the revenue of subsidiaries.
+func (n *OrganizationNode) Sum(value func(node *OrganizationNode) float64) float64 {
+  total := value(n)
+
+  for _, child := range n.Children {
+    total += child.Sum(value)
+  }
+
+  return total
+}
+
+// Find returns the node of an organization in the tree, or nil.
+func (n *OrganizationNode) Find(id int) *OrganizationNode {
+  var found *OrganizationNode
+
+  n.Walk(func(node *OrganizationNode, depth int) error {
+    if node.ID == id && found == nil {
+      found = node
+    }
+
+    return nil
+  })
+
+  return found
+}
+
+// Hierarchy walks the parent relationships of an organization up to its
+// top-most parent and returns the whole tree below it. The organization
+// itself can be looked up in the tree with OrganizationNode.Find.
+//
+// Relationships are listed once per organization in the tree, so large
+// hierarchies take as many requests. Cycles are ignored.
+func (s *OrganizationRelationshipsService) Hierarchy(ctx context.Context, orgID int) (*OrganizationNode, error) {
+  listed := make(map[int][]OrganizationRelationship)
+
+  list := func(id int) ([]OrganizationRelationship, error) {
+    if relationships, ok := listed[id]; ok {
+      return relationships, nil
+    }
+
+    record, _, err := s.List(ctx, id)
+
+    if err != nil {
+      return nil, err
+    }
+
+    if record != nil {
+      listed[id] = record.Data
+    }
+
+    return listed[id], nil
+  }
+
+  rootID, rootName := orgID, ""
+  seen := map[int]bool{orgID: true}
+
+  for {
+    relationships, err := list(rootID)
+
+    if err != nil {
+      return nil, err
+    }
+
+    parentID := 0
+
+    for _, r := range relationships {
+      if r.Type != OrganizationRelationshipParent {
+        continue
+      }
+
+      if r.RelLinkedOrgID.Value == rootID {
+        parentID, rootName = r.RelOwnerOrgID.Value, r.RelOwnerOrgID.Name
+      } else if r.RelOwnerOrgID.Value == rootID && rootName == "" {
+        rootName = r.RelOwnerOrgID.Name
+      }
+    }
+
+    if parentID == 0 || seen[parentID] {
+      break
+    }
+
+    seen[parentID] = true
+    rootID = parentID
+  }
+
+  root := &OrganizationNode{ID: rootID, Name: rootName}
+  added := map[int]bool{rootID: true}
+  queue := []*OrganizationNode{root}
+
+  for len(queue) > 0 {
+    node := queue[0]
+    queue = queue[1:]
+
+    relationships, err := list(node.ID)
+
+    if err != nil {
+      return nil, err
+    }
+
+    for _, r := range relationships {
+      switch {
+      case r.Type == OrganizationRelationshipRelated:
+        other := r.RelLinkedOrgID.Value
+
+        if other == node.ID {
+          other = r.RelOwnerOrgID.Value
+        }
+
+        node.Related = append(node.Related, other)
+
+      case r.RelOwnerOrgID.Value == node.ID:
+        if node.Name == "" {
+          node.Name = r.RelOwnerOrgID.Name
+        }
+
+        if added[r.RelLinkedOrgID.Value] {
+          continue
+        }
+
+        added[r.RelLinkedOrgID.Value] = true
+
+        child := &OrganizationNode{ID: r.RelLinkedOrgID.Value, Name: r.RelLinkedOrgID.Name, Parent: node}
+        node.Children = append(node.Children, child)
+        queue = append(queue, child)
+
+      case r.RelLinkedOrgID.Value == node.ID && node.Name == "":
+        node.Name = r.RelLinkedOrgID.Name
+      }
+    }
+  }
+
+  return root, nil
+}
@@ -2,6 +2,7 @@ package pipedrive
 
 import (
   "context"
+  "encoding/json"
   "fmt"
   "net/http"
 )
@@ -71,6 +72,9 @@ type Organization struct {
   AddressFormattedAddress         interface{} `json:"address_formatted_address"`
   OwnerName                       string      `json:"owner_name"`
   CcEmail                         string      `json:"cc_email"`
+
+  // Values of keys not modelled above, mostly custom fields keyed by their hash.
+  CustomFields map[string]interface{} `json:"-"`
 }
 
 func (o Organization) String() string {
@@ -104,13 +108,22 @@ type OrganizationCreateOptions struct {
   OwnerID   uint      `json:"owner_id"`
   VisibleTo VisibleTo `json:"visible_to"`
   AddTime   Timestamp `json:"add_time"`
+
+  // Custom field values keyed by field key, see CustomFieldResolver.
+  CustomFields map[string]interface{} `json:"-"`
+}
+
+func (o OrganizationCreateOptions) MarshalJSON() ([]byte, error) {
+  type organizationCreateOptions OrganizationCreateOptions
+
+  return marshalCustomFields(organizationCreateOptions(o), o.CustomFields)
 }
 
 // Find all organizations.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations_find
 func (s *OrganizationsService) Find(ctx context.Context, opt *OrganizationFindOptions) (*OrganizationsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/organizations/find", struct {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/organizations/find", struct {
     Term      		string    `url:"term"`
   }{
     opt.Term,
@@ -135,7 +148,7 @@ func (s *OrganizationsService) Find(ctx context.Context, opt *OrganizationFindOp
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations
 func (s *OrganizationsService) List(ctx context.Context) (*OrganizationsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/organizations", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/organizations", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -152,11 +165,32 @@ func (s *OrganizationsService) List(ctx context.Context) (*OrganizationsResponse
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all organizations.
+func (s *OrganizationsService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[Organization] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Organization, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/organizations", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *OrganizationsResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // Create a new organization.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/post_organizations
 func (s *OrganizationsService) Create(ctx context.Context, opt *OrganizationCreateOptions) (*OrganizationResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/organizations", nil, struct {
+  body, err := marshalCustomFields(struct {
     Name      string    `json:"name"`
     OwnerID   uint      `json:"owner_id"`
     VisibleTo VisibleTo `json:"visible_to"`
@@ -166,7 +200,13 @@ func (s *OrganizationsService) Create(ctx context.Context, opt *OrganizationCrea
     opt.OwnerID,
     opt.VisibleTo,
     opt.AddTime.FormatFull(),
-  })
+  }, opt.CustomFields)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/organizations", nil, json.RawMessage(body))
 
   if err != nil {
     return nil, nil, err
@@ -189,7 +229,7 @@ func (s *OrganizationsService) Create(ctx context.Context, opt *OrganizationCrea
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/put_persons_id_merge
 func (s *OrganizationsService) Merge(ctx context.Context, id int, mergeWithID int) (*OrganizationResponse, *Response, error) {
   uri := fmt.Sprintf("/organizations/%v/merge", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, struct {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, struct {
     MergeWithID int `url:"merge_with_id"`
   }{
     mergeWithID,
@@ -215,7 +255,7 @@ func (s *OrganizationsService) Merge(ctx context.Context, id int, mergeWithID in
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/delete_organizations_id_followers_follower_id
 func (s *OrganizationsService) DeleteFollower(ctx context.Context, id int, followerID int) (*Response, error) {
   uri := fmt.Sprintf("/organizations/%v/followers/%v", id, followerID)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -229,7 +269,7 @@ func (s *OrganizationsService) DeleteFollower(ctx context.Context, id int, follo
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/delete_organizations_id
 func (s *OrganizationsService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/organizations/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -242,7 +282,7 @@ func (s *OrganizationsService) Delete(ctx context.Context, id int) (*Response, e
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/delete_organizations
 func (s *OrganizationsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/organizations", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/organizations", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -252,3 +292,369 @@ func (s *OrganizationsService) DeleteMultiple(ctx context.Context, ids []int) (*
 
   return s.client.Do(ctx, req, nil)
 }
+
+// Get a specific organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganization
+func (s *OrganizationsService) Get(ctx context.Context, id int) (*OrganizationResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizations/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *OrganizationResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// OrganizationUpdateOptions specifices the optional parameters to the
+// OrganizationsService.Update method.
+type OrganizationUpdateOptions struct {
+  Name      string    `json:"name,omitempty"`
+  OwnerID   uint      `json:"owner_id,omitempty"`
+  VisibleTo VisibleTo `json:"visible_to,omitempty"`
+
+  // Custom field values keyed by field key, see CustomFieldResolver.
+  CustomFields map[string]interface{} `json:"-"`
+}
+
+func (o OrganizationUpdateOptions) MarshalJSON() ([]byte, error) {
+  type organizationUpdateOptions OrganizationUpdateOptions
+
+  return marshalCustomFields(organizationUpdateOptions(o), o.CustomFields)
+}
+
+// Update an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Organizations#updateOrganization
+func (s *OrganizationsService) Update(ctx context.Context, id int, opt *OrganizationUpdateOptions) (*OrganizationResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizations/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *OrganizationResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListFollowers lists the users following an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationFollowers
+func (s *OrganizationsService) ListFollowers(ctx context.Context, id int) (*FollowersResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizations/%v/followers", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *FollowersResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// AddFollower adds a user as follower of an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Organizations#addOrganizationFollower
+func (s *OrganizationsService) AddFollower(ctx context.Context, id int, userID int) (*FollowerResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizations/%v/followers", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, struct {
+    UserID int `json:"user_id"`
+  }{
+    userID,
+  })
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *FollowerResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListPersons lists the persons of an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationPersons
+func (s *OrganizationsService) ListPersons(ctx context.Context, id int, opt *ListOptions) (*PersonsResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizations/%v/persons", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *PersonsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllPersons returns an iterator over all persons of an organization.
+func (s *OrganizationsService) ListAllPersons(ctx context.Context, id int, opt *ListOptions) *Iterator[Person] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Person, *AdditionalData, *Response, error) {
+    record, resp, err := s.ListPersons(ctx, id, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// OrganizationDealsListOptions specifices the optional parameters to the
+// OrganizationsService.ListDeals method.
+type OrganizationDealsListOptions struct {
+  Status DealStatus `url:"status,omitempty"`
+
+  // Field names and sorting mode, e.g. "title ASC, value DESC".
+  Sort string `url:"sort,omitempty"`
+
+  // Set to 1 to leave out deals where the organization is only a
+  // participant's organization.
+  OnlyPrimaryAssociation uint8 `url:"only_primary_association,omitempty"`
+
+  ListOptions
+}
+
+// ListDeals lists the deals of an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationDeals
+func (s *OrganizationsService) ListDeals(ctx context.Context, id int, opt *OrganizationDealsListOptions) (*DealsResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizations/%v/deals", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *DealsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllDeals returns an iterator over all deals of an organization.
+func (s *OrganizationsService) ListAllDeals(ctx context.Context, id int, opt *OrganizationDealsListOptions) *Iterator[Deal] {
+  var filters OrganizationDealsListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]Deal, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.ListDeals(ctx, id, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// OrganizationActivitiesResponse represents the activities of an organization.
+type OrganizationActivitiesResponse struct {
+  Success        bool           `json:"success"`
+  Data           []Activity     `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// OrganizationActivitiesListOptions specifices the optional parameters to the
+// OrganizationsService.ListActivities method.
+type OrganizationActivitiesListOptions struct {
+  // 0 for activities to do, 1 for done ones. All are returned when nil.
+  Done *uint8 `url:"done,omitempty"`
+
+  // Comma separated IDs of activities left out.
+  Exclude string `url:"exclude,omitempty"`
+
+  ListOptions
+}
+
+// ListActivities lists the activities of an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationActivities
+func (s *OrganizationsService) ListActivities(ctx context.Context, id int, opt *OrganizationActivitiesListOptions) (*OrganizationActivitiesResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizations/%v/activities", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *OrganizationActivitiesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllActivities returns an iterator over all activities of an organization.
+func (s *OrganizationsService) ListAllActivities(ctx context.Context, id int, opt *OrganizationActivitiesListOptions) *Iterator[Activity] {
+  var filters OrganizationActivitiesListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]Activity, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.ListActivities(ctx, id, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// OrganizationFilesListOptions specifices the optional parameters to the
+// OrganizationsService.ListFiles method.
+type OrganizationFilesListOptions struct {
+  IncludeDeletedFiles uint8 `url:"include_deleted_files,omitempty"`
+
+  // Field names and sorting mode, e.g. "update_time DESC".
+  Sort string `url:"sort,omitempty"`
+
+  ListOptions
+}
+
+// ListFiles lists the files attached to an organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationFiles
+func (s *OrganizationsService) ListFiles(ctx context.Context, id int, opt *OrganizationFilesListOptions) (*FilesResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizations/%v/files", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *FilesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllFiles returns an iterator over all files attached to an organization.
+func (s *OrganizationsService) ListAllFiles(ctx context.Context, id int, opt *OrganizationFilesListOptions) *Iterator[File] {
+  var filters OrganizationFilesListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]File, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.ListFiles(ctx, id, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// ListUpdates lists the changelog of an organization: field changes,
+// notes, activities, files and emails.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Organizations#getOrganizationUpdates
+func (s *OrganizationsService) ListUpdates(ctx context.Context, id int, opt *FlowOptions) (*FlowResponse, *Response, error) {
+  uri := fmt.Sprintf("/organizations/%v/flow", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *FlowResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllUpdates returns an iterator over the whole changelog of an organization.
+func (s *OrganizationsService) ListAllUpdates(ctx context.Context, id int, opt *FlowOptions) *Iterator[FlowItem] {
+  var filters FlowOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]FlowItem, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.ListUpdates(ctx, id, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
@@ -0,0 +1,61 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+  "testing"
+)
+
+func TestOrganizationRelationshipsService_Hierarchy(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  // 1 is the parent of 2 and 3, 3 is the parent of 4 and related to 5.
+  relationships := map[string]string{
+    "1": `[{"id":10,"type":"parent","rel_owner_org_id":{"value":1,"name":"Holding"},"rel_linked_org_id":{"value":2,"name":"North"}},
+           {"id":11,"type":"parent","rel_owner_org_id":{"value":1,"name":"Holding"},"rel_linked_org_id":{"value":3,"name":"South"}}]`,
+    "2": `[{"id":10,"type":"parent","rel_owner_org_id":{"value":1,"name":"Holding"},"rel_linked_org_id":{"value":2,"name":"North"}}]`,
+    "3": `[{"id":11,"type":"parent","rel_owner_org_id":{"value":1,"name":"Holding"},"rel_linked_org_id":{"value":3,"name":"South"}},
+           {"id":12,"type":"parent","rel_owner_org_id":{"value":3,"name":"South"},"rel_linked_org_id":{"value":4,"name":"South East"}},
+           {"id":13,"type":"related","rel_owner_org_id":{"value":3,"name":"South"},"rel_linked_org_id":{"value":5,"name":"Partner"}}]`,
+    "4": `[{"id":12,"type":"parent","rel_owner_org_id":{"value":3,"name":"South"},"rel_linked_org_id":{"value":4,"name":"South East"}}]`,
+  }
+
+  requests := 0
+
+  mux.HandleFunc("/organizationRelationships", func(w http.ResponseWriter, r *http.Request) {
+    requests++
+    fmt.Fprintf(w, `{"success":true,"data":%s}`, relationships[r.URL.Query().Get("org_id")])
+  })
+
+  root, err := client.OrganizationRelationships.Hierarchy(context.Background(), 4)
+
+  if err != nil {
+    t.Fatalf("Hierarchy returned error: %v", err)
+  }
+
+  if root.ID != 1 || root.Name != "Holding" || len(root.Children) != 2 {
+    t.Fatalf("Hierarchy returned root %+v", root)
+  }
+
+  south := root.Find(3)
+
+  if south == nil || len(south.Children) != 1 || south.Children[0].ID != 4 || south.Parent != root {
+    t.Fatalf("Hierarchy returned South %+v", south)
+  }
+
+  if len(south.Related) != 1 || south.Related[0] != 5 {
+    t.Errorf("South related to %v, want [5]", south.Related)
+  }
+
+  revenue := map[int]float64{1: 100, 2: 20, 3: 30, 4: 4}
+  total := root.Sum(func(node *OrganizationNode) float64 { return revenue[node.ID] })
+
+  if total != 154 {
+    t.Errorf("Sum = %v, want 154", total)
+  }
+
+  if requests != 4 {
+    t.Errorf("Hierarchy made %d requests, want 4", requests)
+  }
+}
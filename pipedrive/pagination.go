@@ -0,0 +1,134 @@
+package pipedrive
+
+import (
+  "context"
+)
+
+// ListOptions specifies the pagination parameters of collection endpoints.
+type ListOptions struct {
+  Start uint `url:"start,omitempty"`
+  Limit uint `url:"limit,omitempty"`
+
+  // Page to fetch from collections paginated by cursor, which ignore Start.
+  Cursor string `url:"cursor,omitempty"`
+}
+
+// pageFunc fetches a single page of a collection.
+type pageFunc[T any] func(ctx context.Context, page *ListOptions) ([]T, *AdditionalData, *Response, error)
+
+// Iterator walks through every item of a paginated collection, fetching
+// pages lazily as items are consumed. Stop calling Next to end early.
+//
+//   it := client.Deals.ListAll(ctx, &pipedrive.ListOptions{Limit: 100})
+//
+//   for it.Next() {
+//     deal := it.Item()
+//   }
+//
+//   if err := it.Err(); err != nil {
+//     ...
+//   }
+type Iterator[T any] struct {
+  ctx   context.Context
+  fetch pageFunc[T]
+  page  ListOptions
+
+  items    []T
+  index    int
+  item     T
+  response *Response
+  err      error
+  done     bool
+}
+
+func newIterator[T any](ctx context.Context, opt *ListOptions, fetch pageFunc[T]) *Iterator[T] {
+  it := &Iterator[T]{
+    ctx:   ctx,
+    fetch: fetch,
+  }
+
+  if opt != nil {
+    it.page = *opt
+  }
+
+  return it
+}
+
+// Next advances to the next item, fetching the following page when the
+// current one is exhausted. It returns false at the end of the collection
+// or on error.
+func (it *Iterator[T]) Next() bool {
+  for it.index >= len(it.items) {
+    if it.done || it.err != nil {
+      return false
+    }
+
+    it.fetchPage()
+  }
+
+  it.item = it.items[it.index]
+  it.index++
+
+  return true
+}
+
+// Item returns the current item.
+func (it *Iterator[T]) Item() T {
+  return it.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+  return it.err
+}
+
+// Response returns the API response of the last fetched page.
+func (it *Iterator[T]) Response() *Response {
+  return it.response
+}
+
+// All consumes the iterator and returns the remaining items.
+func (it *Iterator[T]) All() ([]T, error) {
+  var items []T
+
+  for it.Next() {
+    items = append(items, it.Item())
+  }
+
+  return items, it.Err()
+}
+
+func (it *Iterator[T]) fetchPage() {
+  if err := it.ctx.Err(); err != nil {
+    it.err = err
+    return
+  }
+
+  page := it.page
+  items, additionalData, resp, err := it.fetch(it.ctx, &page)
+
+  it.response = resp
+  it.items = items
+  it.index = 0
+
+  if err != nil {
+    it.err = err
+    return
+  }
+
+  if additionalData != nil && additionalData.NextCursor != "" && len(items) > 0 {
+    it.page.Cursor = additionalData.NextCursor
+    return
+  }
+
+  if additionalData == nil || !additionalData.Pagination.MoreItemsInCollection || len(items) == 0 {
+    it.done = true
+    return
+  }
+
+  if next := additionalData.Pagination.NextStart; next > 0 {
+    it.page.Start = uint(next)
+  } else {
+    it.page.Start += uint(len(items))
+  }
+}
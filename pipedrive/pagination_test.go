@@ -0,0 +1,88 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+  "testing"
+)
+
+func TestDealService_ListAll(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  var pages int
+
+  mux.HandleFunc("/deals", func(w http.ResponseWriter, r *http.Request) {
+    pages++
+
+    if got := r.URL.Query().Get("limit"); got != "2" {
+      t.Errorf("limit = %q, want 2", got)
+    }
+
+    if got := r.URL.Query().Get("status"); got != "open" {
+      t.Errorf("status = %q, want open", got)
+    }
+
+    switch start := r.URL.Query().Get("start"); start {
+    case "":
+      fmt.Fprint(w, `{"success":true,"data":[{"id":1},{"id":2}],"additional_data":{"pagination":{"start":0,"limit":2,"more_items_in_collection":true,"next_start":2}}}`)
+    case "2":
+      fmt.Fprint(w, `{"success":true,"data":[{"id":3}],"additional_data":{"pagination":{"start":2,"limit":2,"more_items_in_collection":false}}}`)
+    default:
+      t.Errorf("Unexpected start %q", start)
+    }
+  })
+
+  deals, err := client.Deals.ListAll(context.Background(), &DealsListOptions{Status: DealStatusOpen, ListOptions: ListOptions{Limit: 2}}).All()
+
+  if err != nil {
+    t.Fatalf("ListAll returned error: %v", err)
+  }
+
+  if len(deals) != 3 || deals[2].ID != 3 {
+    t.Errorf("ListAll returned %+v", deals)
+  }
+
+  if pages != 2 {
+    t.Errorf("ListAll fetched %d pages, want 2", pages)
+  }
+}
+
+func TestIterator_fetchesLazily(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  var pages int
+
+  mux.HandleFunc("/persons", func(w http.ResponseWriter, r *http.Request) {
+    pages++
+    fmt.Fprint(w, `{"success":true,"data":[{"id":1},{"id":2}],"additional_data":{"pagination":{"more_items_in_collection":true}}}`)
+  })
+
+  it := client.Persons.ListAll(context.Background(), nil)
+
+  if !it.Next() || it.Item().ID != 1 {
+    t.Fatalf("Next returned %+v, %v", it.Item(), it.Err())
+  }
+
+  if pages != 1 {
+    t.Errorf("Iterator fetched %d pages before the first item was consumed, want 1", pages)
+  }
+}
+
+func TestIterator_stopsOnError(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/notes", func(w http.ResponseWriter, r *http.Request) {
+    w.WriteHeader(http.StatusUnauthorized)
+  })
+
+  it := client.Notes.ListAll(context.Background(), nil)
+
+  if it.Next() {
+    t.Fatal("Next returned true")
+  }
+
+  if it.Err() == nil {
+    t.Error("Err returned nil")
+  }
+}
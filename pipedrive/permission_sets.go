@@ -0,0 +1,154 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+)
+
+// PermissionSetsService handles permission sets related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/PermissionSets
+type PermissionSetsService service
+
+// PermissionSetApp is the app a permission set grants access to.
+type PermissionSetApp string
+
+const (
+  PermissionSetAppSales           PermissionSetApp = "sales"
+  PermissionSetAppProjects        PermissionSetApp = "projects"
+  PermissionSetAppCampaigns       PermissionSetApp = "campaigns"
+  PermissionSetAppGlobal          PermissionSetApp = "global"
+  PermissionSetAppAccountSettings PermissionSetApp = "account_settings"
+)
+
+// PermissionSet represents a Pipedrive permission set. Permission sets
+// are identified by UUIDs.
+type PermissionSet struct {
+  ID              string           `json:"id"`
+  Name            string           `json:"name"`
+  Description     string           `json:"description"`
+  App             PermissionSetApp `json:"app"`
+  Type            string           `json:"type"`
+  AssignmentCount int              `json:"assignment_count"`
+
+  // Permissions granted by the set, e.g. "deal_delete".
+  Contents []string `json:"contents"`
+}
+
+func (p PermissionSet) String() string {
+  return Stringify(p)
+}
+
+// PermissionSetAssignment represents a user assigned to a permission set.
+type PermissionSetAssignment struct {
+  UserID          int    `json:"user_id"`
+  PermissionSetID string `json:"permission_set_id"`
+  Name            string `json:"name"`
+}
+
+// PermissionSetsResponse represents multiple permission sets response.
+type PermissionSetsResponse struct {
+  Success bool            `json:"success"`
+  Data    []PermissionSet `json:"data"`
+}
+
+// PermissionSetResponse represents single permission set response.
+type PermissionSetResponse struct {
+  Success bool          `json:"success"`
+  Data    PermissionSet `json:"data"`
+}
+
+// PermissionSetAssignmentsResponse represents multiple permission set
+// assignments response.
+type PermissionSetAssignmentsResponse struct {
+  Success        bool                      `json:"success"`
+  Data           []PermissionSetAssignment `json:"data"`
+  AdditionalData AdditionalData            `json:"additional_data"`
+}
+
+// PermissionSetsListOptions specifices the optional parameters to the
+// PermissionSetsService.List method.
+type PermissionSetsListOptions struct {
+  App PermissionSetApp `url:"app,omitempty"`
+}
+
+// List all permission sets.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/PermissionSets#getPermissionSets
+func (s *PermissionSetsService) List(ctx context.Context, opt *PermissionSetsListOptions) (*PermissionSetsResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/permissionSets", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *PermissionSetsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Get a specific permission set.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/PermissionSets#getPermissionSet
+func (s *PermissionSetsService) Get(ctx context.Context, id string) (*PermissionSetResponse, *Response, error) {
+  uri := fmt.Sprintf("/permissionSets/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *PermissionSetResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAssignments lists the users assigned to a permission set.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/PermissionSets#getPermissionSetAssignments
+func (s *PermissionSetsService) ListAssignments(ctx context.Context, id string, opt *ListOptions) (*PermissionSetAssignmentsResponse, *Response, error) {
+  uri := fmt.Sprintf("/permissionSets/%v/assignments", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *PermissionSetAssignmentsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllAssignments returns an iterator over all users assigned to a
+// permission set.
+func (s *PermissionSetsService) ListAllAssignments(ctx context.Context, id string, opt *ListOptions) *Iterator[PermissionSetAssignment] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]PermissionSetAssignment, *AdditionalData, *Response, error) {
+    record, resp, err := s.ListAssignments(ctx, id, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
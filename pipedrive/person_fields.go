@@ -37,10 +37,7 @@ type PersonField struct {
   MandatoryFlag      bool        `json:"mandatory_flag"`
   DisplayField       string      `json:"display_field,omitempty"`
   Autocomplete       string      `json:"autocomplete,omitempty"`
-  Options            []struct {
-    ID    int    `json:"id"`
-    Label string `json:"label"`
-  } `json:"options,omitempty"`
+  Options            []FieldOption `json:"options,omitempty"`
 }
 
 // PersonFieldsResponse represents multiple person fields response.
@@ -61,7 +58,7 @@ type PersonFieldResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/PersonFields/get_personFields
 func (s *PersonFieldsService) List(ctx context.Context) (*PersonFieldsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/personFields", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/personFields", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -78,12 +75,33 @@ func (s *PersonFieldsService) List(ctx context.Context) (*PersonFieldsResponse,
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all person fields.
+func (s *PersonFieldsService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[PersonField] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]PersonField, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/personFields", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *PersonFieldsResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // GetByID returns a specific person field.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields/get_productFields_id
 func (s *PersonFieldsService) GetByID(ctx context.Context, id int) (*PersonFieldResponse, *Response, error) {
   uri := fmt.Sprintf("/personFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -112,7 +130,7 @@ type PersonFieldCreateOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields/post_productFields
 func (s *PersonFieldsService) Create(ctx context.Context, opt *PersonFieldCreateOptions) (*ProductFieldResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/personFields", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/personFields", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -141,7 +159,7 @@ type PersonFieldUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/PersonFields/put_personFields_id
 func (s *PersonFieldsService) Update(ctx context.Context, id int, opt *PersonFieldUpdateOptions) (*PersonFieldResponse, *Response, error) {
   uri := fmt.Sprintf("/personFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -162,7 +180,7 @@ func (s *PersonFieldsService) Update(ctx context.Context, id int, opt *PersonFie
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/PersonFields/delete_personFields
 func (s *PersonFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/personFields", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/personFields", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -178,7 +196,7 @@ func (s *PersonFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*R
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/PersonFields/delete_personFields_id
 func (s *PersonFieldsService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/personFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
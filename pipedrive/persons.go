@@ -2,6 +2,7 @@ package pipedrive
 
 import (
   "context"
+  "encoding/json"
   "fmt"
   "net/http"
 )
@@ -73,6 +74,9 @@ type Person struct {
   OrgName                         string 		`json:"org_name,omitempty"`
   OwnerName                       string      `json:"owner_name,omitempty"`
   CcEmail                         string      `json:"cc_email,omitempty"`
+
+  // Values of keys not modelled above, mostly custom fields keyed by their hash.
+  CustomFields map[string]interface{} `json:"-"`
 }
 
 func (p Person) String() string {
@@ -149,7 +153,7 @@ type PersonSearchOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/put_persons_id
 func (s *PersonsService) Get(ctx context.Context, id int) (*PersonResponse, *Response, error) {
   uri := fmt.Sprintf("/persons/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -171,7 +175,7 @@ func (s *PersonsService) Get(ctx context.Context, id int) (*PersonResponse, *Res
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Persons#getPersonDeals
 func (s *PersonsService) ListDeals(ctx context.Context, id int) (*PersonDealsResponse, *Response, error) {
   uri := fmt.Sprintf("/persons/%v/deals", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -188,12 +192,34 @@ func (s *PersonsService) ListDeals(ctx context.Context, id int) (*PersonDealsRes
   return record, resp, nil
 }
 
+// ListAllDeals returns an iterator over all deals associated to a person.
+func (s *PersonsService) ListAllDeals(ctx context.Context, id int, opt *ListOptions) *Iterator[Deal] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Deal, *AdditionalData, *Response, error) {
+    uri := fmt.Sprintf("/persons/%v/deals", id)
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *PersonDealsResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // List deals associated to a person
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Persons#getPersonDeals
 func (s *PersonsService) ListActivities(ctx context.Context, id int) (*PersonActivitesResponse, *Response, error) {
   uri := fmt.Sprintf("/persons/%v/activities", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -210,11 +236,33 @@ func (s *PersonsService) ListActivities(ctx context.Context, id int) (*PersonAct
   return record, resp, nil
 }
 
+// ListAllActivities returns an iterator over all activities associated to a person.
+func (s *PersonsService) ListAllActivities(ctx context.Context, id int, opt *ListOptions) *Iterator[Activity] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Activity, *AdditionalData, *Response, error) {
+    uri := fmt.Sprintf("/persons/%v/activities", id)
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *PersonActivitesResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // List all persons.
 //
 // Pipedrive API docs:https://developers.pipedrive.com/docs/api/v1/#!/Persons/get_persons_find
 func (s *PersonsService) Find(ctx context.Context, opt *PersonFindOptions) (*PersonsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/persons/find", struct {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/persons/find", struct {
     Term      		string    `url:"term,omitempty"`
     SearchByEmail   uint      `url:"search_by_email,omitempty"`
   }{
@@ -241,7 +289,7 @@ func (s *PersonsService) Find(ctx context.Context, opt *PersonFindOptions) (*Per
 //
 // Pipedrive API docs:https://developers.pipedrive.com/docs/api/v1/#!/Persons/get_persons
 func (s *PersonsService) Search(ctx context.Context, opt *PersonSearchOptions) (*PersonsSearchResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/persons/search", struct {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/persons/search", struct {
     Term      string    `url:"term,omitempty"`
     Fields    string    `url:"fields,omitempty"`
   }{
@@ -268,7 +316,7 @@ func (s *PersonsService) Search(ctx context.Context, opt *PersonSearchOptions) (
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/get_persons
 func (s *PersonsService) List(ctx context.Context) (*PersonsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/persons", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/persons", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -285,12 +333,33 @@ func (s *PersonsService) List(ctx context.Context) (*PersonsResponse, *Response,
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all persons.
+func (s *PersonsService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[Person] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Person, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/persons", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *PersonsResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // AddFollower adds a follower to person.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/post_persons_id_followers
 func (s *PersonsService) AddFollower(ctx context.Context, id int, userID int) (*PersonAddFollowerResponse, *Response, error) {
   uri := fmt.Sprintf("/persons/%v/followers", id)
-  req, err := s.client.NewRequest(http.MethodPost, uri, nil, struct {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, struct {
     UserID int `json:"user_id,omitempty"`
   }{
     userID,
@@ -321,13 +390,22 @@ type PersonCreateOptions struct {
   Phone     string    `json:"phone,omitempty"`
   VisibleTo VisibleTo `json:"visible_to,omitempty"`
   AddTime   Timestamp `json:"add_time,omitempty"`
+
+  // Custom field values keyed by field key, see CustomFieldResolver.
+  CustomFields map[string]interface{} `json:"-"`
+}
+
+func (o PersonCreateOptions) MarshalJSON() ([]byte, error) {
+  type personCreateOptions PersonCreateOptions
+
+  return marshalCustomFields(personCreateOptions(o), o.CustomFields)
 }
 
 // Create a new person.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/post_persons
 func (s *PersonsService) Create(ctx context.Context, opt *PersonCreateOptions) (*PersonResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/persons", nil, struct {
+  body, err := marshalCustomFields(struct {
     Name      string    `json:"name,omitempty"`
     OwnerID   uint      `json:"owner_id,omitempty"`
     OrgID     uint      `json:"org_id,omitempty"`
@@ -343,7 +421,13 @@ func (s *PersonsService) Create(ctx context.Context, opt *PersonCreateOptions) (
     opt.Phone,
     opt.VisibleTo,
     opt.AddTime.FormatFull(),
-  })
+  }, opt.CustomFields)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/persons", nil, json.RawMessage(body))
 
   if err != nil {
     return nil, nil, err
@@ -369,6 +453,15 @@ type PersonUpdateOptions struct {
   Email     string    `json:"email,omitempty,omitempty"`
   Phone     string    `json:"phone,omitempty,omitempty"`
   VisibleTo VisibleTo `json:"visible_to,omitempty,omitempty"`
+
+  // Custom field values keyed by field key, see CustomFieldResolver.
+  CustomFields map[string]interface{} `json:"-"`
+}
+
+func (o PersonUpdateOptions) MarshalJSON() ([]byte, error) {
+  type personUpdateOptions PersonUpdateOptions
+
+  return marshalCustomFields(personUpdateOptions(o), o.CustomFields)
 }
 
 // Update a specific person.
@@ -376,7 +469,7 @@ type PersonUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/put_persons_id
 func (s *PersonsService) Update(ctx context.Context, id int, opt *PersonUpdateOptions) (*PersonResponse, *Response, error) {
   uri := fmt.Sprintf("/persons/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -398,7 +491,7 @@ func (s *PersonsService) Update(ctx context.Context, id int, opt *PersonUpdateOp
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/put_persons_id_merge
 func (s *PersonsService) Merge(ctx context.Context, id int, mergeWithID int) (*PersonResponse, *Response, error) {
   uri := fmt.Sprintf("/persons/%v/merge", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, struct {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, struct {
     MergeWithID int `json:"merge_with_id,omitempty"`
   }{
     mergeWithID,
@@ -424,7 +517,7 @@ func (s *PersonsService) Merge(ctx context.Context, id int, mergeWithID int) (*P
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/delete_persons_id_followers_follower_id
 func (s *PersonsService) DeleteFollower(ctx context.Context, id int, followerID int) (*Response, error) {
   uri := fmt.Sprintf("/persons/%v/followers/%v", id, followerID)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -438,7 +531,7 @@ func (s *PersonsService) DeleteFollower(ctx context.Context, id int, followerID
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/delete_persons_id
 func (s *PersonsService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/persons/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -452,7 +545,7 @@ func (s *PersonsService) Delete(ctx context.Context, id int) (*Response, error)
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/delete_persons_id_picture
 func (s *PersonsService) DeletePicture(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/persons/%v/picture", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -465,7 +558,7 @@ func (s *PersonsService) DeletePicture(ctx context.Context, id int) (*Response,
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/delete_persons
 func (s *PersonsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/persons", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/persons", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
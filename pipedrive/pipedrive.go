@@ -4,6 +4,7 @@ import (
   "bytes"
   "context"
   "encoding/json"
+  "errors"
   "fmt"
   "io"
   "io/ioutil"
@@ -27,6 +28,11 @@ const (
 
   hostProtocol = "https"
 
+  // Host of company specific API endpoints, prefixed by the company domain.
+  companyDomainHost = "pipedrive.com"
+
+  defaultUserAgent = "go-pipedrive/" + libraryVersion
+
   // The amount of requests current API token can perform for the 10 seconds window.
   headerRateLimit = "X-RateLimit-Limit"
 
@@ -35,6 +41,9 @@ const (
 
   // The amount of seconds before the limit resets.
   headerRateReset = "X-RateLimit-Reset"
+
+  // Reset values below this are seconds rather than unix timestamps.
+  maxRelativeRateReset = 1000000000
 )
 
 type Client struct {
@@ -49,38 +58,62 @@ type Client struct {
   accessToken  string
   useProxy     bool
 
+  // User agent sent with every API request.
+  UserAgent string
+
   rateMutex   sync.Mutex
   currentRate Rate
 
+  // Policy used by Do to retry failed requests.
+  retryPolicy RetryPolicy
+
+  // Optional limiter pacing requests instead of failing them.
+  rateLimiter *rateLimiter
+
+  // OAuth tokens used instead of the API key, when configured.
+  oauth *oauthTokenSource
+
   // Reuse a single struct instead of allocating one for each service.
   common service
 
-  Deals             *DealService
-  Currencies        *CurrenciesService
-  NoteFields        *NoteFieldsService
-  Notes             *NotesService
-  Recents           *RecentsService
-  SearchResults     *SearchResultsService
-  Users             *UsersService
-  Filters           *FiltersService
-  Activities        *ActivitiesService
-  ActivityFields    *ActivityFieldsService
-  ActivityTypes     *ActivityTypesService
-  Authorizations    *AuthorizationsService
-  Stages            *StagesService
-  Webhooks          *WebhooksService
-  UserConnections   *UserConnectionsService
-  GoalsService      *GoalsService
-  PipelinesService  *PipelinesService
-  UserSettings      *UserSettingsService
-  Files             *FilesService
-  ProductFields     *ProductFieldsService
-  Products          *ProductsService
-  PersonFields      *PersonFieldsService
-  OrganizationField *OrganizationFieldsService
-  DealFields        *DealFieldsService
-  Persons           *PersonsService
-  Organizations     *OrganizationsService
+  Deals                     *DealService
+  Currencies                *CurrenciesService
+  NoteFields                *NoteFieldsService
+  Notes                     *NotesService
+  Recents                   *RecentsService
+  SearchResults             *SearchResultsService
+  Users                     *UsersService
+  Filters                   *FiltersService
+  Activities                *ActivitiesService
+  ActivityFields            *ActivityFieldsService
+  ActivityTypes             *ActivityTypesService
+  Authorizations            *AuthorizationsService
+  Stages                    *StagesService
+  Webhooks                  *WebhooksService
+  UserConnections           *UserConnectionsService
+  GoalsService              *GoalsService
+  PipelinesService          *PipelinesService
+  UserSettings              *UserSettingsService
+  Files                     *FilesService
+  ProductFields             *ProductFieldsService
+  Products                  *ProductsService
+  PersonFields              *PersonFieldsService
+  OrganizationField         *OrganizationFieldsService
+  DealFields                *DealFieldsService
+  Persons                   *PersonsService
+  Organizations             *OrganizationsService
+  Leads                     *LeadsService
+  LeadLabels                *LeadLabelsService
+  LeadSources               *LeadSourcesService
+  OrganizationRelationships *OrganizationRelationshipsService
+  Mailbox                   *MailboxService
+  CallLogs                  *CallLogsService
+  Roles                     *RolesService
+  PermissionSets            *PermissionSetsService
+  Projects                  *ProjectsService
+  ProjectTemplates          *ProjectTemplatesService
+  Tasks                     *TasksService
+  ItemSearch                *ItemSearchService
 }
 
 type service struct {
@@ -124,13 +157,28 @@ func parseRateFromResponse(r *http.Response) Rate {
   if reset := r.Header.Get(headerRateReset); reset != "" {
     if value, _ := strconv.ParseInt(reset, 10, 64); value != 0 {
       rate.Reset = Timestamp{time.Unix(value, 0)}
+
+      // Pipedrive sends the amount of seconds before the limit resets,
+      // older responses carried an absolute unix timestamp.
+      if value < maxRelativeRateReset {
+        rate.Reset = Timestamp{time.Now().Add(time.Duration(value) * time.Second)}
+      }
     }
   }
 
   return rate
 }
 
+// NewRequest creates an API request. A relative URL path is resolved against
+// the API endpoint, opt is encoded into the query string and body, if any,
+// is JSON encoded into the request body.
 func (c *Client) NewRequest(method, url string, opt interface{}, body interface{}) (*http.Request, error) {
+  return c.NewRequestWithContext(context.Background(), method, url, opt, body)
+}
+
+// NewRequestWithContext creates an API request bound to ctx, so that its
+// deadline and cancellation apply to the underlying network call.
+func (c *Client) NewRequestWithContext(ctx context.Context, method, url string, opt interface{}, body interface{}) (*http.Request, error) {
   if !strings.HasSuffix(c.BaseURL.Path, "/") && !c.useProxy {
     return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
   }
@@ -154,14 +202,18 @@ func (c *Client) NewRequest(method, url string, opt interface{}, body interface{
     }
   }
 
-  request, err := http.NewRequest(method, u, buf)
+  request, err := http.NewRequestWithContext(ctx, method, u, buf)
+
+  if err != nil {
+    return nil, err
+  }
 
   if c.useProxy {
     request.Header.Set("Authorization", "Bearer " + c.accessToken)
   }
 
-  if err != nil {
-    return nil, err
+  if c.UserAgent != "" {
+    request.Header.Set("User-Agent", c.UserAgent)
   }
 
   if body != nil {
@@ -171,63 +223,166 @@ func (c *Client) NewRequest(method, url string, opt interface{}, body interface{
   return request, nil
 }
 
-func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
+// checkRateLimitBeforeDo fails or, when a rate limiter is configured, waits
+// before a request that would exceed the current rate limit.
+func (c *Client) checkRateLimitBeforeDo(ctx context.Context, req *http.Request) error {
+  if c.rateLimiter != nil {
+    return c.rateLimiter.wait(ctx, req)
+  }
+
   c.rateMutex.Lock()
   rate := c.currentRate
   c.rateMutex.Unlock()
 
-  if !rate.Reset.Time.IsZero() && rate.Remaining == 0 {
-    resp := &http.Response{
-      Status:     http.StatusText(http.StatusForbidden),
-      StatusCode: http.StatusForbidden,
-      Request:    req,
-      Header:     make(http.Header, 0),
-      Body:       ioutil.NopCloser(bytes.NewBufferString("")),
-    }
-
-    return &RateLimitError{
-      Rate:     rate,
-      Response: resp,
-      Message:  fmt.Sprintf("API rate limit of %v exceeded.", rate.Limit),
-    }
+  if !rate.Reset.Time.IsZero() && rate.Remaining == 0 && time.Now().Before(rate.Reset.Time) {
+    return newRateLimitError(req, rate)
   }
 
   return nil
 }
 
+// newRateLimitError builds the error returned for requests that were not sent
+// because of the rate limit.
+func newRateLimitError(req *http.Request, rate Rate) *RateLimitError {
+  resp := &http.Response{
+    Status:     http.StatusText(http.StatusForbidden),
+    StatusCode: http.StatusForbidden,
+    Request:    req,
+    Header:     make(http.Header, 0),
+    Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+  }
+
+  return &RateLimitError{
+    Rate:     rate,
+    Response: resp,
+    Message:  fmt.Sprintf("API rate limit of %v exceeded.", rate.Limit),
+  }
+}
+
 func (c *Client) checkResponse(r *http.Response) error {
   if code := r.StatusCode; 200 <= code && code <= 299 {
     return nil
   }
 
   data, err := ioutil.ReadAll(r.Body)
-  errorResponse := &ErrorResponse{Response: r}
+  errorResponse := &ErrorResponse{Response: r, Body: data}
 
-  if err == nil && data != nil {
+  if err == nil && len(data) > 0 {
     json.Unmarshal(data, errorResponse)
   }
 
+  if errorResponse.Message == "" {
+    errorResponse.Message = errorResponse.ErrorFields.Error
+  }
+
   switch {
-  case r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
+  case r.StatusCode == http.StatusTooManyRequests,
+    r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
     return &RateLimitError{
       Rate:     parseRateFromResponse(r),
       Response: errorResponse.Response,
+      Message:  errorResponse.Message,
+      Body:     data,
     }
 
+  case r.StatusCode == http.StatusUnauthorized:
+    return &AuthError{errorResponse}
+
+  case r.StatusCode == http.StatusForbidden:
+    return &PermissionError{errorResponse}
+
+  case r.StatusCode == http.StatusNotFound:
+    return &NotFoundError{errorResponse}
+
+  case r.StatusCode == http.StatusBadRequest, r.StatusCode == http.StatusUnprocessableEntity:
+    return &ValidationError{errorResponse}
+
+  case r.StatusCode >= http.StatusInternalServerError:
+    return &ServerError{errorResponse}
+
   default:
     return errorResponse
   }
 }
 
-// Do sends an API request and returns the API response. T
+// Do sends an API request and returns the API response. Failed requests
+// are retried according to the client retry policy.
+//
+// The response body is JSON decoded into v, or copied to v when v is an
+// io.Writer. Requests failing while the body is copied are not retried,
+// since part of the body may already have been written.
 //
-// The provided ctx must be non-nil. If it is canceled or times out,
-// ctx.Err() will be returned.
+// The provided ctx must be non-nil and is bound to the request. If it is
+// canceled or times out, ctx.Err() will be returned.
 func (c *Client) Do(ctx context.Context, request *http.Request, v interface{}) (*Response, error) {
-  if err := c.checkRateLimitBeforeDo(request); err != nil {
-    return &Response{
-      Response: err.Response,
-    }, err
+  request = request.WithContext(ctx)
+
+  policy := c.retryPolicy
+  refreshed := false
+
+  for attempt := 1; ; attempt++ {
+    response, err := c.do(ctx, request, v)
+
+    // Retry once with a refreshed token when an OAuth token got rejected.
+    var authErr *AuthError
+
+    if c.oauth != nil && !refreshed && errors.As(err, &authErr) && canRewindBody(request) {
+      refreshed = true
+      rejected := strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer ")
+
+      if _, refreshErr := c.oauth.refresh(ctx, rejected); refreshErr != nil {
+        return response, refreshErr
+      }
+
+      if err := rewindBody(request); err != nil {
+        return response, err
+      }
+
+      attempt--
+      continue
+    }
+
+    if err == nil || attempt >= policy.maxAttempts() || !policy.shouldRetry(request, response, err) {
+      return response, err
+    }
+
+    if err := rewindBody(request); err != nil {
+      return response, err
+    }
+
+    timer := time.NewTimer(policy.delay(attempt, response, err))
+
+    select {
+    case <-ctx.Done():
+      timer.Stop()
+      return response, ctx.Err()
+    case <-timer.C:
+    }
+  }
+}
+
+// do performs a single attempt of an API request.
+func (c *Client) do(ctx context.Context, request *http.Request, v interface{}) (*Response, error) {
+  if err := c.checkRateLimitBeforeDo(ctx, request); err != nil {
+    var rateErr *RateLimitError
+
+    if errors.As(err, &rateErr) {
+      return &Response{
+        Response: rateErr.Response,
+      }, err
+    }
+
+    return nil, err
+  }
+
+  if c.oauth != nil {
+    token, err := c.oauth.token(ctx)
+
+    if err != nil {
+      return nil, err
+    }
+
+    request.Header.Set("Authorization", "Bearer " + token.AccessToken)
   }
 
   resp, err := c.client.Do(request)
@@ -253,40 +408,79 @@ func (c *Client) Do(ctx context.Context, request *http.Request, v interface{}) (
   c.currentRate = response.Rate
   c.rateMutex.Unlock()
 
+  if c.rateLimiter != nil {
+    c.rateLimiter.update(response.Rate)
+  }
+
   err = c.checkResponse(response.Response)
 
   if err != nil {
     return response, err
   }
 
-  err = json.NewDecoder(resp.Body).Decode(v)
+  if v == nil {
+    return response, nil
+  }
+
+  if w, ok := v.(io.Writer); ok {
+    return response, copyBody(w, resp)
+  }
 
-  if err == io.EOF {
+  data, err := ioutil.ReadAll(resp.Body)
+
+  if err != nil {
+    return response, err
+  }
+
+  if len(bytes.TrimSpace(data)) == 0 {
     return response, nil
   }
 
+  if err := json.Unmarshal(data, v); err != nil {
+    return response, newDecodeError(resp, err, data)
+  }
+
   return response, nil
 }
 
-func (c *Client) createRequestUrl(path string, opt interface{}) (string, error) {
-  uri, err := c.BaseURL.Parse(hostProtocol + "://" + defaultBaseUrl + "v" + libraryVersion)
+// responseStarter is implemented by writers that inspect a response
+// before its body is copied to them.
+type responseStarter interface {
+  startResponse(resp *http.Response) error
+}
 
-  if (c.useProxy) {
-    uri, err = c.BaseURL.Parse(hostProtocol + "://" + defaultProxyUrl)
+// copyBody copies the body of resp to w.
+func copyBody(w io.Writer, resp *http.Response) error {
+  if starter, ok := w.(responseStarter); ok {
+    if err := starter.startResponse(resp); err != nil {
+      return err
+    }
   }
 
+  n, err := io.Copy(w, resp.Body)
+
   if err != nil {
-    return path, err
+    return &StreamError{Response: resp, Err: err, Written: n}
   }
 
-  uri.Path += path
+  return nil
+}
+
+func (c *Client) createRequestUrl(path string, opt interface{}) (string, error) {
+  // Resolve against BaseURL, keeping field selectors like "deals:(id)"
+  // from being read as a URL scheme.
+  uri, err := c.BaseURL.Parse("./" + strings.TrimPrefix(path, "/"))
+
+  if err != nil {
+    return path, err
+  }
 
   v := reflect.ValueOf(opt)
 
   if v.Kind() == reflect.Ptr && v.IsNil() {
     parameters := url.Values{}
 
-    if !c.useProxy {
+    if c.useAPIToken() {
       parameters.Add("api_token", c.apiKey)
     }
 
@@ -301,7 +495,7 @@ func (c *Client) createRequestUrl(path string, opt interface{}) (string, error)
     return path, err
   }
 
-  if !c.useProxy {
+  if c.useAPIToken() {
     qs.Add("api_token", c.apiKey)
   }
 
@@ -310,6 +504,11 @@ func (c *Client) createRequestUrl(path string, opt interface{}) (string, error)
   return uri.String(), nil
 }
 
+// useAPIToken reports whether requests are authenticated with the API key.
+func (c *Client) useAPIToken() bool {
+  return !c.useProxy && c.oauth == nil
+}
+
 func (c *Client) SetOptions(options ...func(*Client) error) error {
   for _, opt := range options {
     if err := opt(c); err != nil {
@@ -320,16 +519,37 @@ func (c *Client) SetOptions(options ...func(*Client) error) error {
   return nil
 }
 
+// NewValidatedClient returns a new client like NewClient, but fails when
+// the configuration is invalid, e.g. when CompanyDomain is set but is not
+// a valid company domain.
+func NewValidatedClient(options *Config) (*Client, error) {
+  if options.CompanyDomain != "" {
+    if _, err := companyDomainURL(options.CompanyDomain); err != nil {
+      return nil, err
+    }
+  }
+
+  return NewClient(options), nil
+}
+
+// NewClient returns a new client. An invalid CompanyDomain is ignored and
+// requests are sent to the default API endpoint; use NewValidatedClient to
+// report it.
 func NewClient(options *Config) *Client {
-  baseURL, _ := url.Parse(defaultBaseUrl)
+  baseURL, _ := url.Parse(hostProtocol + "://" + defaultBaseUrl + "v" + libraryVersion + "/")
+
+  if domainURL, err := companyDomainURL(options.CompanyDomain); err == nil {
+    baseURL = domainURL
+  }
 
   if options.UseProxy {
-    baseURL, _ = url.Parse(defaultProxyUrl)
+    baseURL, _ = url.Parse(hostProtocol + "://" + defaultProxyUrl + "/")
   }
 
   c := &Client{
-    client:  http.DefaultClient,
+    client:  &http.Client{},
     BaseURL: baseURL,
+    UserAgent: defaultUserAgent,
     apiKey:  options.APIKey,
     accessToken: options.AccessToken,
     useProxy: options.UseProxy,
@@ -363,6 +583,18 @@ func NewClient(options *Config) *Client {
   c.DealFields = (*DealFieldsService)(&c.common)
   c.Persons = (*PersonsService)(&c.common)
   c.Organizations = (*OrganizationsService)(&c.common)
+  c.Leads = (*LeadsService)(&c.common)
+  c.LeadLabels = (*LeadLabelsService)(&c.common)
+  c.LeadSources = (*LeadSourcesService)(&c.common)
+  c.OrganizationRelationships = (*OrganizationRelationshipsService)(&c.common)
+  c.Mailbox = (*MailboxService)(&c.common)
+  c.CallLogs = (*CallLogsService)(&c.common)
+  c.Roles = (*RolesService)(&c.common)
+  c.PermissionSets = (*PermissionSetsService)(&c.common)
+  c.Projects = (*ProjectsService)(&c.common)
+  c.ProjectTemplates = (*ProjectTemplatesService)(&c.common)
+  c.Tasks = (*TasksService)(&c.common)
+  c.ItemSearch = (*ItemSearchService)(&c.common)
 
   return c
 }
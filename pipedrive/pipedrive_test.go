@@ -0,0 +1,53 @@
+package pipedrive
+
+import (
+  "context"
+  "net/http"
+  "net/http/httptest"
+  "testing"
+  "time"
+)
+
+// setup starts a test HTTP server and a client talking to it. Tests
+// register handlers on the returned mux.
+func setup(t *testing.T) (*Client, *http.ServeMux, string) {
+  t.Helper()
+
+  mux := http.NewServeMux()
+  server := httptest.NewServer(mux)
+
+  t.Cleanup(server.Close)
+
+  client := NewClient(&Config{
+    APIKey: "test-token",
+  })
+
+  if err := client.SetOptions(WithBaseURL(server.URL)); err != nil {
+    t.Fatal(err)
+  }
+
+  return client, mux, server.URL
+}
+
+func TestDo_bindsContext(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  release := make(chan struct{})
+  defer close(release)
+
+  mux.HandleFunc("/deals", func(w http.ResponseWriter, r *http.Request) {
+    select {
+    case <-release:
+    case <-r.Context().Done():
+    }
+  })
+
+  ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+  defer cancel()
+
+  req, _ := client.NewRequest(http.MethodGet, "/deals", nil, nil)
+
+  if _, err := client.Do(ctx, req, nil); err != context.DeadlineExceeded {
+    t.Errorf("Do returned %v, want context.DeadlineExceeded", err)
+  }
+}
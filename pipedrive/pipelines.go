@@ -118,7 +118,7 @@ type PipelineDealsMovementResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines
 func (s *PipelinesService) List(ctx context.Context) (*PipelinesResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/pipelines", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/pipelines", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -140,7 +140,7 @@ func (s *PipelinesService) List(ctx context.Context) (*PipelinesResponse, *Respo
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id
 func (s *PipelinesService) GetByID(ctx context.Context, id int) (*PipelineResponse, *Response, error) {
   uri := fmt.Sprintf("/pipelines/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -162,7 +162,7 @@ func (s *PipelinesService) GetByID(ctx context.Context, id int) (*PipelineRespon
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_deals
 func (s *PipelinesService) GetDeals(ctx context.Context, id int) (*PipelinesResponse, *Response, error) {
   uri := fmt.Sprintf("/pipelines/%v/deals", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -184,7 +184,7 @@ func (s *PipelinesService) GetDeals(ctx context.Context, id int) (*PipelinesResp
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_conversion_statistics
 func (s *PipelinesService) GetDealsConversionRate(ctx context.Context, id int, startDate Timestamp, endDate Timestamp) (*PipelineDealsConversionRateResponse, *Response, error) {
   uri := fmt.Sprintf("/pipelines/%v/conversion_statistics", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, struct {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, struct {
     StartDate string `url:"start_date,omitempty"`
     EndDate   string `url:"end_date,omitempty"`
   }{
@@ -212,7 +212,7 @@ func (s *PipelinesService) GetDealsConversionRate(ctx context.Context, id int, s
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_movement_statistics
 func (s *PipelinesService) GetDealsMovement(ctx context.Context, id int, startDate Timestamp, endDate Timestamp) (*PipelineDealsMovementResponse, *Response, error) {
   uri := fmt.Sprintf("/pipelines/%v/movement_statistics", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, struct {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, struct {
     StartDate string `url:"start_date,omitempty"`
     EndDate   string `url:"end_date,omitempty"`
   }{
@@ -248,7 +248,7 @@ type PipelineCreateOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/post_pipelines
 func (s *PipelinesService) Create(ctx context.Context, opt *PipelineCreateOptions) (*PipelineResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/pipelines", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/pipelines", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -279,7 +279,7 @@ type PipelineUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/put_pipelines_id
 func (s *PipelinesService) Update(ctx context.Context, id int, opt *PipelineUpdateOptions) (*PipelineResponse, *Response, error) {
   uri := fmt.Sprintf("/pipelines/%v", id)
-  req, err := s.client.NewRequest(http.MethodPost, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -301,7 +301,7 @@ func (s *PipelinesService) Update(ctx context.Context, id int, opt *PipelineUpda
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/delete_pipelines_id
 func (s *PipelinesService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/pipelines/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -36,10 +36,7 @@ type ProductField struct {
   Link               string      `json:"link,omitempty"`
   MandatoryFlag      bool        `json:"mandatory_flag"`
   DisplayField       string      `json:"display_field,omitempty"`
-  Options            []struct {
-    ID    bool   `json:"id"`
-    Label string `json:"label"`
-  } `json:"options,omitempty"`
+  Options            []FieldOption `json:"options,omitempty"`
 }
 
 func (p ProductField) String() string {
@@ -64,7 +61,7 @@ type ProductFieldResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields/get_productFields
 func (s *ProductFieldsService) List(ctx context.Context) (*ProductFieldsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/productFields", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/productFields", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -81,12 +78,33 @@ func (s *ProductFieldsService) List(ctx context.Context) (*ProductFieldsResponse
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all product fields.
+func (s *ProductFieldsService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[ProductField] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]ProductField, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/productFields", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *ProductFieldsResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // GetByID returns a specific product field.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields/get_productFields_id
 func (s *ProductFieldsService) GetByID(ctx context.Context, id int) (*ProductFieldResponse, *Response, error) {
   uri := fmt.Sprintf("/productFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -115,7 +133,7 @@ type ProductFieldCreateOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields/post_productFields
 func (s *ProductFieldsService) Create(ctx context.Context, opt *ProductFieldCreateOptions) (*ProductFieldResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/productFields", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/productFields", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -144,7 +162,7 @@ type ProductFieldUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields/put_productFields_id
 func (s *ProductFieldsService) Update(ctx context.Context, id int, opt *ProductFieldUpdateOptions) (*ProductFieldResponse, *Response, error) {
   uri := fmt.Sprintf("/productFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -165,7 +183,7 @@ func (s *ProductFieldsService) Update(ctx context.Context, id int, opt *ProductF
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields/delete_productFields
 func (s *ProductFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/productFields", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/productFields", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -181,7 +199,7 @@ func (s *ProductFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields/delete_productFields_id
 func (s *ProductFieldsService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/productFields/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
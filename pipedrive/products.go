@@ -32,24 +32,46 @@ type Product struct {
     ActiveFlag bool   `json:"active_flag"`
     Value      int    `json:"value"`
   } `json:"owner_id"`
-  FilesCount     interface{} `json:"files_count"`
-  FollowersCount int         `json:"followers_count"`
-  AddTime        string      `json:"add_time"`
-  UpdateTime     string      `json:"update_time"`
-  Prices         []struct {
-    ID           int    `json:"id"`
-    ProductID    int    `json:"product_id"`
-    Price        int    `json:"price"`
-    Currency     string `json:"currency"`
-    Cost         int    `json:"cost"`
-    OverheadCost int    `json:"overhead_cost"`
-  } `json:"prices"`
+  FilesCount     interface{}    `json:"files_count"`
+  FollowersCount int            `json:"followers_count"`
+  AddTime        string         `json:"add_time"`
+  UpdateTime     string         `json:"update_time"`
+  Prices         []ProductPrice `json:"prices"`
+
+  // Values of keys not modelled above, mostly custom fields keyed by their hash.
+  CustomFields map[string]interface{} `json:"-"`
 }
 
 func (p Product) String() string {
   return Stringify(p)
 }
 
+// ProductPrice represents the price of a product, or of one of its
+// variations, in a currency.
+type ProductPrice struct {
+  ID                 int     `json:"id,omitempty"`
+  ProductID          int     `json:"product_id,omitempty"`
+  ProductVariationID int     `json:"product_variation_id,omitempty"`
+  Price              float64 `json:"price"`
+  Currency           string  `json:"currency"`
+  Cost               float64 `json:"cost,omitempty"`
+  OverheadCost       float64 `json:"overhead_cost,omitempty"`
+  Notes              string  `json:"notes,omitempty"`
+}
+
+// ProductVariation represents a variation of a product, e.g. a size, with
+// its own prices.
+type ProductVariation struct {
+  ID        int            `json:"id"`
+  Name      string         `json:"name"`
+  ProductID int            `json:"product_id"`
+  Prices    []ProductPrice `json:"prices"`
+}
+
+func (v ProductVariation) String() string {
+  return Stringify(v)
+}
+
 // ProductsResponse represents multiple products response.
 type ProductsResponse struct {
   Success        bool           `json:"success"`
@@ -76,7 +98,7 @@ type ProductAttachedDealsResponse struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products_id_deals
 func (s *ProductsService) GetAttachedDeals(ctx context.Context, id int) (*ProductAttachedDealsResponse, *Response, error) {
   uri := fmt.Sprintf("/products/%v/deals", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -93,11 +115,33 @@ func (s *ProductsService) GetAttachedDeals(ctx context.Context, id int) (*Produc
   return record, resp, nil
 }
 
+// ListAllAttachedDeals returns an iterator over all deals a product is attached to.
+func (s *ProductsService) ListAllAttachedDeals(ctx context.Context, id int, opt *ListOptions) *Iterator[Deal] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Deal, *AdditionalData, *Response, error) {
+    uri := fmt.Sprintf("/products/%v/deals", id)
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *ProductAttachedDealsResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // List returns all data about products.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products
 func (s *ProductsService) List(ctx context.Context) (*ProductsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/products", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/products", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -114,6 +158,27 @@ func (s *ProductsService) List(ctx context.Context) (*ProductsResponse, *Respons
   return record, resp, nil
 }
 
+// ListAll returns an iterator over all products.
+func (s *ProductsService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[Product] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Product, *AdditionalData, *Response, error) {
+    req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/products", page, nil)
+
+    if err != nil {
+      return nil, nil, nil, err
+    }
+
+    var record *ProductsResponse
+
+    resp, err := s.client.Do(ctx, req, &record)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
 // ProductFindOptions specifices the optional parameters to the
 // ProductFindOptions.Find method.
 type ProductFindOptions struct {
@@ -124,7 +189,7 @@ type ProductFindOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products_find
 func (s *ProductsService) Find(ctx context.Context, term string) (*ProductsResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/products/find", &ProductFindOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/products/find", &ProductFindOptions{
     Term: term,
   }, nil)
 
@@ -148,7 +213,7 @@ func (s *ProductsService) Find(ctx context.Context, term string) (*ProductsRespo
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products_id
 func (s *ProductsService) GetByID(ctx context.Context, id int) (*ProductResponse, *Response, error) {
   uri := fmt.Sprintf("/products/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -168,21 +233,21 @@ func (s *ProductsService) GetByID(ctx context.Context, id int) (*ProductResponse
 // ProductCreateOptions specifices the optional parameters to the
 // ProductsService.Create method.
 type ProductCreateOptions struct {
-  Name       string     `url:"name"`
-  Code       string     `url:"code"`
-  Unit       string     `url:"unit"`
-  Tax        int        `url:"tax"`
-  ActiveFlag ActiveFlag `url:"active_flag"`
-  VisibleTo  VisibleTo  `url:"visible_to"`
-  OwnerID    int        `url:"owner_id"`
-  Prices     string     `url:"prices"`
+  Name       string         `json:"name"`
+  Code       string         `json:"code,omitempty"`
+  Unit       string         `json:"unit,omitempty"`
+  Tax        *int           `json:"tax,omitempty"`
+  ActiveFlag *ActiveFlag    `json:"active_flag,omitempty"`
+  VisibleTo  *VisibleTo     `json:"visible_to,omitempty"`
+  OwnerID    int            `json:"owner_id,omitempty"`
+  Prices     []ProductPrice `json:"prices,omitempty"`
 }
 
 // Create a new product.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/post_products
 func (s *ProductsService) Create(ctx context.Context, opt *ProductCreateOptions) (*ProductResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/products", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/products", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -202,14 +267,14 @@ func (s *ProductsService) Create(ctx context.Context, opt *ProductCreateOptions)
 // ProductUpdateOptions specifices the optional parameters to the
 // ProductsService.Update method.
 type ProductUpdateOptions struct {
-  Name       string     `url:"name"`
-  Code       string     `url:"code"`
-  Unit       string     `url:"unit"`
-  Tax        int        `url:"tax"`
-  ActiveFlag ActiveFlag `url:"active_flag"`
-  VisibleTo  VisibleTo  `url:"visible_to"`
-  OwnerID    int        `url:"owner_id"`
-  Prices     string     `url:"prices"`
+  Name       string         `json:"name,omitempty"`
+  Code       string         `json:"code,omitempty"`
+  Unit       string         `json:"unit,omitempty"`
+  Tax        *int           `json:"tax,omitempty"`
+  ActiveFlag *ActiveFlag    `json:"active_flag,omitempty"`
+  VisibleTo  *VisibleTo     `json:"visible_to,omitempty"`
+  OwnerID    int            `json:"owner_id,omitempty"`
+  Prices     []ProductPrice `json:"prices,omitempty"`
 }
 
 // Update a specific product.
@@ -217,7 +282,7 @@ type ProductUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/put_products_id
 func (s *ProductsService) Update(ctx context.Context, id int, opt *ProductUpdateOptions) (*ProductResponse, *Response, error) {
   uri := fmt.Sprintf("/products/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -239,7 +304,7 @@ func (s *ProductsService) Update(ctx context.Context, id int, opt *ProductUpdate
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/delete_products_id
 func (s *ProductsService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/products/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -253,7 +318,194 @@ func (s *ProductsService) Delete(ctx context.Context, id int) (*Response, error)
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/delete_products_id_followers_follower_id
 func (s *ProductsService) DeleteFollower(ctx context.Context, id int, followerID int) (*Response, error) {
   uri := fmt.Sprintf("/products/%v/followers/%v", id, followerID)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
+
+  if err != nil {
+    return nil, err
+  }
+
+  return s.client.Do(ctx, req, nil)
+}
+
+// ListFollowers lists the users following a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Products#getProductFollowers
+func (s *ProductsService) ListFollowers(ctx context.Context, id int) (*FollowersResponse, *Response, error) {
+  uri := fmt.Sprintf("/products/%v/followers", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *FollowersResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// AddFollower adds a user as follower of a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Products#addProductFollower
+func (s *ProductsService) AddFollower(ctx context.Context, id int, userID int) (*FollowerResponse, *Response, error) {
+  uri := fmt.Sprintf("/products/%v/followers", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, struct {
+    UserID int `json:"user_id"`
+  }{
+    userID,
+  })
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *FollowerResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListPermittedUsers lists the IDs of users allowed to access a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Products#getProductUsers
+func (s *ProductsService) ListPermittedUsers(ctx context.Context, id int) (*PermittedUsersResponse, *Response, error) {
+  uri := fmt.Sprintf("/products/%v/permittedUsers", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *PermittedUsersResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ProductVariationsResponse represents multiple product variations response.
+type ProductVariationsResponse struct {
+  Success        bool               `json:"success"`
+  Data           []ProductVariation `json:"data"`
+  AdditionalData AdditionalData     `json:"additional_data"`
+}
+
+// ProductVariationResponse represents single product variation response.
+type ProductVariationResponse struct {
+  Success bool             `json:"success"`
+  Data    ProductVariation `json:"data"`
+}
+
+// ListVariations lists the variations of a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Products#getProductVariations
+func (s *ProductsService) ListVariations(ctx context.Context, id int, opt *ListOptions) (*ProductVariationsResponse, *Response, error) {
+  uri := fmt.Sprintf("/products/%v/variations", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProductVariationsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllVariations returns an iterator over all variations of a product.
+func (s *ProductsService) ListAllVariations(ctx context.Context, id int, opt *ListOptions) *Iterator[ProductVariation] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]ProductVariation, *AdditionalData, *Response, error) {
+    record, resp, err := s.ListVariations(ctx, id, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// ProductVariationOptions specifices the optional parameters to the
+// ProductsService.AddVariation and ProductsService.UpdateVariation methods.
+// Name is required when adding a variation.
+type ProductVariationOptions struct {
+  Name string `json:"name,omitempty"`
+
+  // Prices of the variation. On update, they replace the prices in the
+  // same currencies.
+  Prices []ProductPrice `json:"prices,omitempty"`
+}
+
+// AddVariation adds a variation to a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Products#addProductVariation
+func (s *ProductsService) AddVariation(ctx context.Context, id int, opt *ProductVariationOptions) (*ProductVariationResponse, *Response, error) {
+  uri := fmt.Sprintf("/products/%v/variations", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProductVariationResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// UpdateVariation updates a variation of a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Products#updateProductVariation
+func (s *ProductsService) UpdateVariation(ctx context.Context, id int, variationID int, opt *ProductVariationOptions) (*ProductVariationResponse, *Response, error) {
+  uri := fmt.Sprintf("/products/%v/variations/%v", id, variationID)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPatch, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProductVariationResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// DeleteVariation deletes a variation of a product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Products#deleteProductVariation
+func (s *ProductsService) DeleteVariation(ctx context.Context, id int, variationID int) (*Response, error) {
+  uri := fmt.Sprintf("/products/%v/variations/%v", id, variationID)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
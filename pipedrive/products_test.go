@@ -0,0 +1,103 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "testing"
+)
+
+func TestProductsService_AddVariation(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/products/4/variations", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodPost {
+      t.Errorf("method = %v, want POST", r.Method)
+    }
+
+    var body map[string]interface{}
+    json.NewDecoder(r.Body).Decode(&body)
+
+    prices := body["prices"].([]interface{})
+    price := prices[0].(map[string]interface{})
+
+    if body["name"] != "Large" || len(price) != 3 || price["price"] != 12.5 || price["currency"] != "EUR" {
+      t.Errorf("body = %v", body)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":{"id":7,"name":"Large","product_id":4,
+      "prices":[{"product_variation_id":7,"price":12.5,"currency":"EUR","cost":4,"notes":""}]}}`)
+  })
+
+  record, _, err := client.Products.AddVariation(context.Background(), 4, &ProductVariationOptions{
+    Name:   "Large",
+    Prices: []ProductPrice{{Price: 12.5, Currency: "EUR", Cost: 4}},
+  })
+
+  if err != nil {
+    t.Fatalf("AddVariation returned error: %v", err)
+  }
+
+  if record.Data.ID != 7 || record.Data.Prices[0].ProductVariationID != 7 || record.Data.Prices[0].Price != 12.5 {
+    t.Errorf("AddVariation returned %+v", record.Data)
+  }
+}
+
+func TestProductsService_Create(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/products", func(w http.ResponseWriter, r *http.Request) {
+    var body map[string]interface{}
+    json.NewDecoder(r.Body).Decode(&body)
+
+    prices, _ := body["prices"].([]interface{})
+
+    if body["name"] != "Widget" || body["visible_to"] != 3.0 || len(prices) != 1 || prices[0].(map[string]interface{})["currency"] != "USD" {
+      t.Errorf("body = %v", body)
+    }
+
+    fmt.Fprint(w, `{"success":true}`)
+  })
+
+  visibleTo := VisibleToEntireCompany
+
+  _, _, err := client.Products.Create(context.Background(), &ProductCreateOptions{
+    Name:      "Widget",
+    VisibleTo: &visibleTo,
+    Prices:    []ProductPrice{{Price: 10, Currency: "USD"}},
+  })
+
+  if err != nil {
+    t.Fatalf("Create returned error: %v", err)
+  }
+}
+
+func TestProductsService_Update_deactivate(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/products/7", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodPut {
+      t.Errorf("Request method = %v, want %v", r.Method, http.MethodPut)
+    }
+
+    var body map[string]interface{}
+    json.NewDecoder(r.Body).Decode(&body)
+
+    if got, ok := body["active_flag"]; !ok || got != 0.0 || len(body) != 1 {
+      t.Errorf("body = %v, want only active_flag 0", body)
+    }
+
+    fmt.Fprint(w, `{"success":true}`)
+  })
+
+  activeFlag := ActiveFlagDisabled
+
+  _, _, err := client.Products.Update(context.Background(), 7, &ProductUpdateOptions{
+    ActiveFlag: &activeFlag,
+  })
+
+  if err != nil {
+    t.Fatalf("Update returned error: %v", err)
+  }
+}
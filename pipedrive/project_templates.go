@@ -0,0 +1,97 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+)
+
+// ProjectTemplatesService handles project templates related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/ProjectTemplates
+type ProjectTemplatesService service
+
+// ProjectTemplate represents a template projects are created from.
+type ProjectTemplate struct {
+  ID              int    `json:"id"`
+  Title           string `json:"title"`
+  Description     string `json:"description"`
+  ProjectsBoardID int    `json:"projects_board_id"`
+  OwnerID         int    `json:"owner_id"`
+  AddTime         string `json:"add_time"`
+  UpdateTime      string `json:"update_time"`
+}
+
+func (p ProjectTemplate) String() string {
+  return Stringify(p)
+}
+
+// ProjectTemplatesResponse represents multiple project templates response.
+type ProjectTemplatesResponse struct {
+  Success        bool              `json:"success"`
+  Data           []ProjectTemplate `json:"data"`
+  AdditionalData AdditionalData    `json:"additional_data"`
+}
+
+// ProjectTemplateResponse represents single project template response.
+type ProjectTemplateResponse struct {
+  Success bool            `json:"success"`
+  Data    ProjectTemplate `json:"data"`
+}
+
+// List project templates. Project templates are paginated by cursor.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/ProjectTemplates#getProjectTemplates
+func (s *ProjectTemplatesService) List(ctx context.Context, opt *ListOptions) (*ProjectTemplatesResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/projectTemplates", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectTemplatesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAll returns an iterator over all project templates.
+func (s *ProjectTemplatesService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[ProjectTemplate] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]ProjectTemplate, *AdditionalData, *Response, error) {
+    record, resp, err := s.List(ctx, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// Get a specific project template.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/ProjectTemplates#getProjectTemplate
+func (s *ProjectTemplatesService) Get(ctx context.Context, id int) (*ProjectTemplateResponse, *Response, error) {
+  uri := fmt.Sprintf("/projectTemplates/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectTemplateResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
@@ -0,0 +1,599 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+)
+
+// ProjectsService handles projects related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects
+type ProjectsService service
+
+// ProjectStatus is the status of a project.
+type ProjectStatus string
+
+const (
+  ProjectStatusOpen      ProjectStatus = "open"
+  ProjectStatusCompleted ProjectStatus = "completed"
+  ProjectStatusCanceled  ProjectStatus = "canceled"
+  ProjectStatusDeleted   ProjectStatus = "deleted"
+)
+
+// ProjectPlanItemType is the kind of item planned in a project.
+type ProjectPlanItemType string
+
+const (
+  ProjectPlanItemActivity ProjectPlanItemType = "activity"
+  ProjectPlanItemTask     ProjectPlanItemType = "task"
+)
+
+// Project represents a Pipedrive project.
+type Project struct {
+  ID               int           `json:"id"`
+  Title            string        `json:"title"`
+  Description      string        `json:"description"`
+  Status           ProjectStatus `json:"status"`
+  BoardID          int           `json:"board_id"`
+  PhaseID          int           `json:"phase_id"`
+  OwnerID          int           `json:"owner_id"`
+  OrgID            *int          `json:"org_id"`
+  PersonID         *int          `json:"person_id"`
+  DealIDs          []int         `json:"deal_ids"`
+  Labels           []int         `json:"labels"`
+  StartDate        string        `json:"start_date"`
+  EndDate          string        `json:"end_date"`
+  AddTime          string        `json:"add_time"`
+  UpdateTime       string        `json:"update_time"`
+  StatusChangeTime string        `json:"status_change_time"`
+  ArchiveTime      *string       `json:"archive_time"`
+}
+
+func (p Project) String() string {
+  return Stringify(p)
+}
+
+// ProjectBoard represents a board projects are laid out on.
+type ProjectBoard struct {
+  ID         int    `json:"id"`
+  Name       string `json:"name"`
+  OrderNr    int    `json:"order_nr"`
+  AddTime    string `json:"add_time"`
+  UpdateTime string `json:"update_time"`
+}
+
+// ProjectPhase represents a column of a project board.
+type ProjectPhase struct {
+  ID         int    `json:"id"`
+  Name       string `json:"name"`
+  BoardID    int    `json:"board_id"`
+  OrderNr    int    `json:"order_nr"`
+  AddTime    string `json:"add_time"`
+  UpdateTime string `json:"update_time"`
+}
+
+// ProjectGroup represents a group of activities and tasks in a project.
+type ProjectGroup struct {
+  ID      int    `json:"id"`
+  Name    string `json:"name"`
+  OrderNr int    `json:"order_nr"`
+}
+
+// ProjectPlanItem represents the place of an activity or a task in the
+// plan of a project.
+type ProjectPlanItem struct {
+  ItemID   int                 `json:"item_id"`
+  ItemType ProjectPlanItemType `json:"item_type"`
+  PhaseID  *int                `json:"phase_id"`
+  GroupID  *int                `json:"group_id"`
+}
+
+// ProjectsResponse represents multiple projects response.
+type ProjectsResponse struct {
+  Success        bool           `json:"success"`
+  Data           []Project      `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// ProjectResponse represents single project response.
+type ProjectResponse struct {
+  Success bool    `json:"success"`
+  Data    Project `json:"data"`
+}
+
+// ProjectBoardsResponse represents multiple project boards response.
+type ProjectBoardsResponse struct {
+  Success bool           `json:"success"`
+  Data    []ProjectBoard `json:"data"`
+}
+
+// ProjectBoardResponse represents single project board response.
+type ProjectBoardResponse struct {
+  Success bool         `json:"success"`
+  Data    ProjectBoard `json:"data"`
+}
+
+// ProjectPhasesResponse represents multiple project phases response.
+type ProjectPhasesResponse struct {
+  Success bool           `json:"success"`
+  Data    []ProjectPhase `json:"data"`
+}
+
+// ProjectPhaseResponse represents single project phase response.
+type ProjectPhaseResponse struct {
+  Success bool         `json:"success"`
+  Data    ProjectPhase `json:"data"`
+}
+
+// ProjectGroupsResponse represents project groups response.
+type ProjectGroupsResponse struct {
+  Success bool           `json:"success"`
+  Data    []ProjectGroup `json:"data"`
+}
+
+// ProjectPlanResponse represents project plan response.
+type ProjectPlanResponse struct {
+  Success bool              `json:"success"`
+  Data    []ProjectPlanItem `json:"data"`
+}
+
+// ProjectPlanItemResponse represents single project plan item response.
+type ProjectPlanItemResponse struct {
+  Success bool            `json:"success"`
+  Data    ProjectPlanItem `json:"data"`
+}
+
+// ProjectActivitiesResponse represents the activities of a project.
+type ProjectActivitiesResponse struct {
+  Success        bool           `json:"success"`
+  Data           []Activity     `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// ProjectsListOptions specifices the optional parameters to the
+// ProjectsService.List method. Projects are paginated by cursor.
+type ProjectsListOptions struct {
+  FilterID        uint          `url:"filter_id,omitempty"`
+  Status          ProjectStatus `url:"status,omitempty"`
+  PhaseID         uint          `url:"phase_id,omitempty"`
+  IncludeArchived bool          `url:"include_archived,omitempty"`
+
+  ListOptions
+}
+
+// List projects.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProjects
+func (s *ProjectsService) List(ctx context.Context, opt *ProjectsListOptions) (*ProjectsResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/projects", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAll returns an iterator over all projects matching opt.
+func (s *ProjectsService) ListAll(ctx context.Context, opt *ProjectsListOptions) *Iterator[Project] {
+  var filters ProjectsListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]Project, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.List(ctx, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// Get a specific project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProject
+func (s *ProjectsService) Get(ctx context.Context, id int) (*ProjectResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ProjectCreateOptions specifices the parameters to the
+// ProjectsService.Create method. Title, BoardID and PhaseID are required.
+type ProjectCreateOptions struct {
+  Title       string        `json:"title"`
+  BoardID     int           `json:"board_id"`
+  PhaseID     int           `json:"phase_id"`
+  Description string        `json:"description,omitempty"`
+  Status      ProjectStatus `json:"status,omitempty"`
+  OwnerID     int           `json:"owner_id,omitempty"`
+  OrgID       int           `json:"org_id,omitempty"`
+  PersonID    int           `json:"person_id,omitempty"`
+  DealIDs     []int         `json:"deal_ids,omitempty"`
+  Labels      []int         `json:"labels,omitempty"`
+
+  // Dates formatted as YYYY-MM-DD.
+  StartDate string `json:"start_date,omitempty"`
+  EndDate   string `json:"end_date,omitempty"`
+
+  // Template the activities and tasks of the project are copied from.
+  TemplateID int `json:"template_id,omitempty"`
+}
+
+// LinkDeals links the project to deals. The organization and person of
+// the first deal become those of the project, unless already set.
+func (o *ProjectCreateOptions) LinkDeals(deals ...Deal) *ProjectCreateOptions {
+  for _, deal := range deals {
+    o.DealIDs = append(o.DealIDs, deal.ID)
+  }
+
+  if len(deals) == 0 {
+    return o
+  }
+
+  if o.OrgID == 0 {
+    o.OrgID = deals[0].OrgID.Value
+  }
+
+  if o.PersonID == 0 {
+    o.PersonID = deals[0].PersonID.Value
+  }
+
+  if o.Title == "" {
+    o.Title = deals[0].Title
+  }
+
+  return o
+}
+
+// Create a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#addProject
+func (s *ProjectsService) Create(ctx context.Context, opt *ProjectCreateOptions) (*ProjectResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/projects", nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ProjectUpdateOptions specifices the optional parameters to the
+// ProjectsService.Update method.
+type ProjectUpdateOptions struct {
+  Title       string        `json:"title,omitempty"`
+  BoardID     int           `json:"board_id,omitempty"`
+  PhaseID     int           `json:"phase_id,omitempty"`
+  Description string        `json:"description,omitempty"`
+  Status      ProjectStatus `json:"status,omitempty"`
+  OwnerID     int           `json:"owner_id,omitempty"`
+  OrgID       int           `json:"org_id,omitempty"`
+  PersonID    int           `json:"person_id,omitempty"`
+  DealIDs     []int         `json:"deal_ids,omitempty"`
+  Labels      []int         `json:"labels,omitempty"`
+
+  // Dates formatted as YYYY-MM-DD.
+  StartDate string `json:"start_date,omitempty"`
+  EndDate   string `json:"end_date,omitempty"`
+}
+
+// Update a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#updateProject
+func (s *ProjectsService) Update(ctx context.Context, id int, opt *ProjectUpdateOptions) (*ProjectResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Delete a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#deleteProject
+func (s *ProjectsService) Delete(ctx context.Context, id int) (*Response, error) {
+  uri := fmt.Sprintf("/projects/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
+
+  if err != nil {
+    return nil, err
+  }
+
+  return s.client.Do(ctx, req, nil)
+}
+
+// Archive a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#archiveProject
+func (s *ProjectsService) Archive(ctx context.Context, id int) (*ProjectResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/%v/archive", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// GetPlan returns the phase and group of every activity and task of a
+// project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProjectPlan
+func (s *ProjectsService) GetPlan(ctx context.Context, id int) (*ProjectPlanResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/%v/plan", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectPlanResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ProjectPlanItemOptions specifices the optional parameters to the
+// ProjectsService.UpdateActivityPlan and ProjectsService.UpdateTaskPlan
+// methods.
+type ProjectPlanItemOptions struct {
+  PhaseID int `json:"phase_id,omitempty"`
+  GroupID int `json:"group_id,omitempty"`
+}
+
+// UpdateActivityPlan moves an activity to another phase or group of a
+// project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#putProjectPlanActivity
+func (s *ProjectsService) UpdateActivityPlan(ctx context.Context, id int, activityID int, opt *ProjectPlanItemOptions) (*ProjectPlanItemResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/%v/plan/activities/%v", id, activityID)
+
+  return s.updatePlan(ctx, uri, opt)
+}
+
+// UpdateTaskPlan moves a task to another phase or group of a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#putProjectPlanTask
+func (s *ProjectsService) UpdateTaskPlan(ctx context.Context, id int, taskID int, opt *ProjectPlanItemOptions) (*ProjectPlanItemResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/%v/plan/tasks/%v", id, taskID)
+
+  return s.updatePlan(ctx, uri, opt)
+}
+
+func (s *ProjectsService) updatePlan(ctx context.Context, uri string, opt *ProjectPlanItemOptions) (*ProjectPlanItemResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectPlanItemResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListGroups lists the groups of a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProjectGroups
+func (s *ProjectsService) ListGroups(ctx context.Context, id int) (*ProjectGroupsResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/%v/groups", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectGroupsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListActivities lists the activities of a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProjectActivities
+func (s *ProjectsService) ListActivities(ctx context.Context, id int) (*ProjectActivitiesResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/%v/activities", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectActivitiesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListTasks lists the tasks of a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProjectTasks
+func (s *ProjectsService) ListTasks(ctx context.Context, id int) (*TasksResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/%v/tasks", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *TasksResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListBoards lists the project boards.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProjectsBoards
+func (s *ProjectsService) ListBoards(ctx context.Context) (*ProjectBoardsResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/projects/boards", nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectBoardsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// GetBoard returns a specific project board.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProjectsBoard
+func (s *ProjectsService) GetBoard(ctx context.Context, id int) (*ProjectBoardResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/boards/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectBoardResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListPhases lists the phases of a project board.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProjectsPhases
+func (s *ProjectsService) ListPhases(ctx context.Context, boardID int) (*ProjectPhasesResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/projects/phases", struct {
+    BoardID int `url:"board_id"`
+  }{
+    boardID,
+  }, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectPhasesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// GetPhase returns a specific project phase.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Projects#getProjectsPhase
+func (s *ProjectsService) GetPhase(ctx context.Context, id int) (*ProjectPhaseResponse, *Response, error) {
+  uri := fmt.Sprintf("/projects/phases/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *ProjectPhaseResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
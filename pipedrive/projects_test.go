@@ -0,0 +1,66 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "testing"
+)
+
+func TestProjectsService_Create_linkDeals(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+    var body ProjectCreateOptions
+    json.NewDecoder(r.Body).Decode(&body)
+
+    if body.Title != "Onboarding ACME" || body.OrgID != 3 || body.PersonID != 4 || len(body.DealIDs) != 2 || body.DealIDs[1] != 2 {
+      t.Errorf("body = %+v", body)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":{"id":10,"title":"Onboarding ACME","deal_ids":[1,2],"org_id":3}}`)
+  })
+
+  deal := Deal{ID: 1, Title: "ACME renewal", OrgID: OrgID{Value: 3}, PersonID: PersonID{Value: 4}}
+  opt := (&ProjectCreateOptions{Title: "Onboarding ACME", BoardID: 1, PhaseID: 2}).LinkDeals(deal, Deal{ID: 2})
+
+  record, _, err := client.Projects.Create(context.Background(), opt)
+
+  if err != nil {
+    t.Fatalf("Create returned error: %v", err)
+  }
+
+  if record.Data.ID != 10 || *record.Data.OrgID != 3 {
+    t.Errorf("Create returned %+v", record.Data)
+  }
+}
+
+func TestTasksService_ListSubtasks_cursor(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+    if got := r.URL.Query().Get("parent_task_id"); got != "5" {
+      t.Errorf("parent_task_id = %q, want 5", got)
+    }
+
+    switch cursor := r.URL.Query().Get("cursor"); cursor {
+    case "":
+      fmt.Fprint(w, `{"success":true,"data":[{"id":6,"parent_task_id":5}],"additional_data":{"next_cursor":"eyJpZCI6Nn0"}}`)
+    case "eyJpZCI6Nn0":
+      fmt.Fprint(w, `{"success":true,"data":[{"id":7,"parent_task_id":5,"done":1}],"additional_data":{"next_cursor":null}}`)
+    default:
+      t.Errorf("Unexpected cursor %q", cursor)
+    }
+  })
+
+  tasks, err := client.Tasks.ListSubtasks(context.Background(), 5, nil).All()
+
+  if err != nil {
+    t.Fatalf("ListSubtasks returned error: %v", err)
+  }
+
+  if len(tasks) != 2 || tasks[1].ID != 7 || tasks[1].Done != 1 {
+    t.Errorf("ListSubtasks returned %+v", tasks)
+  }
+}
@@ -0,0 +1,178 @@
+package pipedrive
+
+import (
+  "context"
+  "errors"
+  "net/http"
+  "sync"
+  "time"
+)
+
+const (
+  // Window the X-RateLimit-Limit header applies to.
+  defaultRateLimitWindow = 10 * time.Second
+)
+
+// RateLimiterOptions configures the blocking rate limiter.
+type RateLimiterOptions struct {
+  // Longest time a request waits for the rate limit. Requests that would
+  // have to wait longer fail with a RateLimitError. Zero means requests
+  // wait as long as their context allows.
+  MaxWait time.Duration
+
+  // Window the X-RateLimit-Limit header applies to. Defaults to 10 seconds.
+  Window time.Duration
+}
+
+// WithRateLimiter makes the client wait for the rate limit to reset
+// instead of failing, and paces requests so that goroutines sharing
+// the client stay within the limit reported by Pipedrive.
+func WithRateLimiter(opt RateLimiterOptions) func(*Client) error {
+  return func(c *Client) error {
+    if opt.MaxWait < 0 {
+      return errors.New("rate limiter MaxWait must not be negative")
+    }
+
+    if opt.Window < 0 {
+      return errors.New("rate limiter Window must not be negative")
+    }
+
+    if opt.Window == 0 {
+      opt.Window = defaultRateLimitWindow
+    }
+
+    c.rateLimiter = &rateLimiter{
+      maxWait: opt.MaxWait,
+      window:  opt.Window,
+    }
+
+    return nil
+  }
+}
+
+// rateLimiter is a token bucket sized and refilled from the rate limit
+// headers of the latest response.
+type rateLimiter struct {
+  maxWait time.Duration
+  window  time.Duration
+
+  mu      sync.Mutex
+  limit   int
+  tokens  float64
+  updated time.Time
+  reset   time.Time
+}
+
+// refill adds the tokens earned since the last update.
+func (l *rateLimiter) refill(now time.Time) {
+  if !l.reset.IsZero() && !now.Before(l.reset) {
+    l.tokens = float64(l.limit)
+    l.reset = time.Time{}
+  }
+
+  if elapsed := now.Sub(l.updated); elapsed > 0 {
+    l.tokens += elapsed.Seconds() * l.ratePerSecond()
+  }
+
+  if l.tokens > float64(l.limit) {
+    l.tokens = float64(l.limit)
+  }
+
+  l.updated = now
+}
+
+func (l *rateLimiter) ratePerSecond() float64 {
+  return float64(l.limit) / l.window.Seconds()
+}
+
+// reserve takes a token and returns how long to wait before using it.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+  // Nothing is known about the limit before the first response.
+  if l.limit == 0 {
+    return 0
+  }
+
+  l.refill(now)
+  l.tokens--
+
+  var wait time.Duration
+
+  if l.tokens < 0 {
+    wait = time.Duration(-l.tokens / l.ratePerSecond() * float64(time.Second))
+  }
+
+  if untilReset := l.reset.Sub(now); untilReset > wait {
+    wait = untilReset
+  }
+
+  return wait
+}
+
+// update feeds the limiter with the rate reported by a response.
+func (l *rateLimiter) update(rate Rate) {
+  if rate.Limit == 0 {
+    return
+  }
+
+  l.mu.Lock()
+  defer l.mu.Unlock()
+
+  now := time.Now()
+
+  if l.limit == 0 {
+    l.tokens = float64(rate.Remaining)
+    l.updated = now
+  }
+
+  l.limit = rate.Limit
+  l.refill(now)
+
+  if remaining := float64(rate.Remaining); remaining < l.tokens {
+    l.tokens = remaining
+  }
+
+  if rate.Remaining == 0 && rate.Reset.After(now) {
+    l.reset = rate.Reset.Time
+  }
+}
+
+// wait blocks until the request may be sent, the context is done or
+// the wait would exceed MaxWait.
+func (l *rateLimiter) wait(ctx context.Context, req *http.Request) error {
+  l.mu.Lock()
+  now := time.Now()
+  delay := l.reserve(now)
+  limit := l.limit
+  l.mu.Unlock()
+
+  if delay <= 0 {
+    return nil
+  }
+
+  if l.maxWait > 0 && delay > l.maxWait {
+    l.release()
+
+    return newRateLimitError(req, Rate{
+      Limit: limit,
+      Reset: Timestamp{now.Add(delay)},
+    })
+  }
+
+  timer := time.NewTimer(delay)
+  defer timer.Stop()
+
+  select {
+  case <-ctx.Done():
+    l.release()
+    return ctx.Err()
+  case <-timer.C:
+    return nil
+  }
+}
+
+// release gives back a token taken by a request that was not sent.
+func (l *rateLimiter) release() {
+  l.mu.Lock()
+  l.tokens++
+  l.mu.Unlock()
+}
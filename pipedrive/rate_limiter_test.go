@@ -0,0 +1,69 @@
+package pipedrive
+
+import (
+  "context"
+  "errors"
+  "net/http"
+  "testing"
+  "time"
+)
+
+func TestRateLimiter_waitsForReset(t *testing.T) {
+  limiter := &rateLimiter{window: time.Second}
+  limiter.update(Rate{Limit: 10, Remaining: 0, Reset: Timestamp{time.Now().Add(50 * time.Millisecond)}})
+
+  req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+  start := time.Now()
+
+  if err := limiter.wait(context.Background(), req); err != nil {
+    t.Fatalf("wait returned error: %v", err)
+  }
+
+  if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+    t.Errorf("wait returned after %v, want at least until reset", elapsed)
+  }
+}
+
+func TestRateLimiter_maxWaitExceeded(t *testing.T) {
+  limiter := &rateLimiter{window: time.Second, maxWait: time.Millisecond}
+  limiter.update(Rate{Limit: 10, Remaining: 0, Reset: Timestamp{time.Now().Add(time.Hour)}})
+
+  req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+  var rateErr *RateLimitError
+
+  if err := limiter.wait(context.Background(), req); !errors.As(err, &rateErr) {
+    t.Fatalf("wait returned %v, want *RateLimitError", err)
+  }
+}
+
+func TestRateLimiter_contextCanceled(t *testing.T) {
+  limiter := &rateLimiter{window: time.Second}
+  limiter.update(Rate{Limit: 10, Remaining: 0, Reset: Timestamp{time.Now().Add(time.Hour)}})
+
+  req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+  ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+  defer cancel()
+
+  if err := limiter.wait(ctx, req); err != context.DeadlineExceeded {
+    t.Fatalf("wait returned %v, want context.DeadlineExceeded", err)
+  }
+}
+
+func TestRateLimiter_pacesRequests(t *testing.T) {
+  limiter := &rateLimiter{window: time.Second}
+  limiter.update(Rate{Limit: 100, Remaining: 1})
+
+  limiter.mu.Lock()
+  first := limiter.reserve(time.Now())
+  second := limiter.reserve(time.Now())
+  limiter.mu.Unlock()
+
+  if first != 0 {
+    t.Errorf("first reservation waits %v, want 0", first)
+  }
+
+  if second <= 0 {
+    t.Errorf("second reservation waits %v, want a positive delay", second)
+  }
+}
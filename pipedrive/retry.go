@@ -0,0 +1,275 @@
+package pipedrive
+
+import (
+  "errors"
+  "io"
+  "math/rand"
+  "net"
+  "net/http"
+  "strconv"
+  "sync"
+  "time"
+)
+
+const (
+  headerRetryAfter = "Retry-After"
+
+  defaultRetryMaxAttempts = 3
+
+  defaultRetryBaseDelay = 500 * time.Millisecond
+
+  defaultRetryMaxDelay = 30 * time.Second
+)
+
+// RetryPolicy configures how Client.Do retries failed requests.
+//
+// A zero value policy performs a single attempt, which is the default
+// behaviour of a client created by NewClient.
+type RetryPolicy struct {
+  // Maximum number of attempts, including the first one.
+  MaxAttempts int
+
+  // Delay before the first retry. Each following retry doubles it.
+  BaseDelay time.Duration
+
+  // Upper bound for a single delay, including delays requested by
+  // the Retry-After and X-RateLimit-Reset headers.
+  MaxDelay time.Duration
+
+  // Fraction (0 to 1) of the computed delay that is randomized.
+  Jitter float64
+
+  // Response status codes that are retried. Defaults to 429 and 5xx
+  // gateway errors when empty.
+  RetryableStatusCodes []int
+
+  // Reports whether a transport error is retried. Defaults to
+  // IsRetryableError when nil.
+  RetryableError func(error) bool
+
+  // Retry methods that are not idempotent (POST, PATCH). These are
+  // always retried when the request was rejected by a rate limit, as
+  // Pipedrive did not process it.
+  RetryUnsafeMethods bool
+}
+
+// DefaultRetryPolicy returns a policy suitable for most clients.
+func DefaultRetryPolicy() RetryPolicy {
+  return RetryPolicy{
+    MaxAttempts: defaultRetryMaxAttempts,
+    BaseDelay:   defaultRetryBaseDelay,
+    MaxDelay:    defaultRetryMaxDelay,
+    Jitter:      0.2,
+  }
+}
+
+var defaultRetryableStatusCodes = []int{
+  http.StatusTooManyRequests,
+  http.StatusInternalServerError,
+  http.StatusBadGateway,
+  http.StatusServiceUnavailable,
+  http.StatusGatewayTimeout,
+}
+
+// WithRetryPolicy enables automatic retries for the client.
+func WithRetryPolicy(policy RetryPolicy) func(*Client) error {
+  return func(c *Client) error {
+    if policy.MaxAttempts < 0 {
+      return errors.New("retry policy MaxAttempts must not be negative")
+    }
+
+    if policy.BaseDelay < 0 || policy.MaxDelay < 0 {
+      return errors.New("retry policy delays must not be negative")
+    }
+
+    if policy.Jitter < 0 || policy.Jitter > 1 {
+      return errors.New("retry policy Jitter must be between 0 and 1")
+    }
+
+    c.retryPolicy = policy
+
+    return nil
+  }
+}
+
+// IsRetryableError reports whether a transport error is likely to be
+// transient: timeouts, refused or reset connections and truncated responses.
+func IsRetryableError(err error) bool {
+  if err == nil {
+    return false
+  }
+
+  if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+    return true
+  }
+
+  var netErr net.Error
+
+  if errors.As(err, &netErr) && netErr.Timeout() {
+    return true
+  }
+
+  var opErr *net.OpError
+
+  return errors.As(err, &opErr)
+}
+
+func (p RetryPolicy) maxAttempts() int {
+  if p.MaxAttempts < 1 {
+    return 1
+  }
+
+  return p.MaxAttempts
+}
+
+func (p RetryPolicy) isRetryableStatus(code int) bool {
+  codes := p.RetryableStatusCodes
+
+  if len(codes) == 0 {
+    codes = defaultRetryableStatusCodes
+  }
+
+  for _, c := range codes {
+    if c == code {
+      return true
+    }
+  }
+
+  return false
+}
+
+// retriesMethod reports whether requests with the given method are retried.
+// Requests rejected by a rate limit were not processed by Pipedrive, so
+// they are retried whatever their method.
+func (p RetryPolicy) retriesMethod(method string, rateLimited bool) bool {
+  return p.RetryUnsafeMethods || rateLimited || isIdempotentMethod(method)
+}
+
+// shouldRetry decides whether the outcome of an attempt is retried.
+func (p RetryPolicy) shouldRetry(req *http.Request, resp *Response, err error) bool {
+  var streamErr *StreamError
+
+  if !canRewindBody(req) || errors.As(err, &streamErr) {
+    return false
+  }
+
+  var rateErr *RateLimitError
+
+  rateLimited := errors.As(err, &rateErr)
+
+  if !p.retriesMethod(req.Method, rateLimited) {
+    return false
+  }
+
+  // Rate limits may also be reported with 403 Forbidden, or by requests
+  // refused before being sent, which have no status code of their own.
+  if rateLimited {
+    return p.isRetryableStatus(http.StatusTooManyRequests)
+  }
+
+  if resp != nil && resp.Response != nil {
+    return p.isRetryableStatus(resp.StatusCode)
+  }
+
+  if p.RetryableError != nil {
+    return p.RetryableError(err)
+  }
+
+  return IsRetryableError(err)
+}
+
+// delay returns how long to wait before the given retry attempt.
+func (p RetryPolicy) delay(attempt int, resp *Response, err error) time.Duration {
+  d := p.BaseDelay
+
+  for i := 1; i < attempt && (p.MaxDelay == 0 || d < p.MaxDelay); i++ {
+    d *= 2
+  }
+
+  if p.Jitter > 0 && d > 0 {
+    d -= time.Duration(p.Jitter * jitterFloat64() * float64(d))
+  }
+
+  if wait, ok := serverRequestedDelay(resp, err); ok && wait > d {
+    d = wait
+  }
+
+  if p.MaxDelay > 0 && d > p.MaxDelay {
+    d = p.MaxDelay
+  }
+
+  return d
+}
+
+// serverRequestedDelay reads Retry-After and the rate limit reset time.
+func serverRequestedDelay(resp *Response, err error) (time.Duration, bool) {
+  var rateErr *RateLimitError
+
+  if errors.As(err, &rateErr) && !rateErr.Rate.Reset.IsZero() {
+    return time.Until(rateErr.Rate.Reset.Time), true
+  }
+
+  if resp == nil || resp.Response == nil {
+    return 0, false
+  }
+
+  if v := resp.Header.Get(headerRetryAfter); v != "" {
+    if seconds, err := strconv.Atoi(v); err == nil {
+      return time.Duration(seconds) * time.Second, true
+    }
+
+    if t, err := http.ParseTime(v); err == nil {
+      return time.Until(t), true
+    }
+  }
+
+  if !resp.Rate.Reset.IsZero() && resp.Rate.Remaining == 0 {
+    return time.Until(resp.Rate.Reset.Time), true
+  }
+
+  return 0, false
+}
+
+// rewindBody prepares the request body to be sent again.
+func rewindBody(req *http.Request) error {
+  if req.GetBody == nil || req.Body == nil || req.Body == http.NoBody {
+    return nil
+  }
+
+  body, err := req.GetBody()
+
+  if err != nil {
+    return err
+  }
+
+  req.Body = body
+
+  return nil
+}
+
+// canRewindBody reports whether the request body can be sent again.
+// Streamed bodies, such as multipart uploads, can not.
+func canRewindBody(req *http.Request) bool {
+  return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func isIdempotentMethod(method string) bool {
+  switch method {
+  case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+    return true
+  }
+
+  return false
+}
+
+var (
+  jitterMutex  sync.Mutex
+  jitterSource = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+func jitterFloat64() float64 {
+  jitterMutex.Lock()
+  defer jitterMutex.Unlock()
+
+  return jitterSource.Float64()
+}
@@ -0,0 +1,131 @@
+package pipedrive
+
+import (
+  "context"
+  "io/ioutil"
+  "net/http"
+  "sync/atomic"
+  "testing"
+  "time"
+)
+
+func testRetryPolicy() RetryPolicy {
+  return RetryPolicy{
+    MaxAttempts: 3,
+    BaseDelay:   time.Millisecond,
+    MaxDelay:    10 * time.Millisecond,
+  }
+}
+
+func TestDo_retriesServerErrors(t *testing.T) {
+  client, mux, _ := setup(t)
+  client.SetOptions(WithRetryPolicy(testRetryPolicy()))
+
+  var calls int32
+
+  mux.HandleFunc("/deals", func(w http.ResponseWriter, r *http.Request) {
+    if atomic.AddInt32(&calls, 1) < 3 {
+      w.WriteHeader(http.StatusBadGateway)
+      return
+    }
+
+    w.Write([]byte(`{"success":true}`))
+  })
+
+  req, _ := client.NewRequest(http.MethodGet, "/deals", nil, nil)
+
+  var record *DealsResponse
+
+  if _, err := client.Do(context.Background(), req, &record); err != nil {
+    t.Fatalf("Do returned error: %v", err)
+  }
+
+  if calls != 3 {
+    t.Errorf("Do made %d attempts, want 3", calls)
+  }
+
+  if !record.Success {
+    t.Error("Do did not decode the successful attempt")
+  }
+}
+
+func TestDo_retryResendsBody(t *testing.T) {
+  client, mux, _ := setup(t)
+  client.SetOptions(WithRetryPolicy(testRetryPolicy()))
+
+  var calls int32
+
+  mux.HandleFunc("/deals", func(w http.ResponseWriter, r *http.Request) {
+    body, _ := ioutil.ReadAll(r.Body)
+
+    if string(body) != "{\"title\":\"x\"}\n" {
+      t.Errorf("Request body: %q", body)
+    }
+
+    if atomic.AddInt32(&calls, 1) == 1 {
+      w.Header().Set("Retry-After", "0")
+      w.WriteHeader(http.StatusTooManyRequests)
+      return
+    }
+
+    w.Write([]byte(`{"success":true}`))
+  })
+
+  req, _ := client.NewRequest(http.MethodPost, "/deals", nil, map[string]string{"title": "x"})
+
+  if _, err := client.Do(context.Background(), req, nil); err != nil {
+    t.Fatalf("Do returned error: %v", err)
+  }
+
+  if calls != 2 {
+    t.Errorf("Do made %d attempts, want 2", calls)
+  }
+}
+
+func TestDo_doesNotRetryUnsafeMethods(t *testing.T) {
+  client, mux, _ := setup(t)
+  client.SetOptions(WithRetryPolicy(testRetryPolicy()))
+
+  var calls int32
+
+  mux.HandleFunc("/deals", func(w http.ResponseWriter, r *http.Request) {
+    atomic.AddInt32(&calls, 1)
+    w.WriteHeader(http.StatusInternalServerError)
+  })
+
+  req, _ := client.NewRequest(http.MethodPost, "/deals", nil, nil)
+
+  if _, err := client.Do(context.Background(), req, nil); err == nil {
+    t.Fatal("Do returned no error")
+  }
+
+  if calls != 1 {
+    t.Errorf("Do made %d attempts, want 1", calls)
+  }
+}
+
+func TestRetryPolicy_shouldRetryRateLimits(t *testing.T) {
+  req, _ := http.NewRequest(http.MethodPost, "/deals", nil)
+  resp := &http.Response{StatusCode: http.StatusForbidden, Request: req}
+  err := &RateLimitError{Response: resp}
+
+  if policy := testRetryPolicy(); !policy.shouldRetry(req, &Response{Response: resp}, err) {
+    t.Error("shouldRetry = false for a rate limited POST, want true")
+  }
+
+  policy := testRetryPolicy()
+  policy.RetryableStatusCodes = []int{http.StatusBadGateway}
+
+  if policy.shouldRetry(req, &Response{Response: resp}, err) {
+    t.Error("shouldRetry = true without 429 in RetryableStatusCodes, want false")
+  }
+}
+
+func TestRetryPolicy_delayHonorsRetryAfter(t *testing.T) {
+  policy := RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Minute}
+  resp := &Response{Response: &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}}
+
+  if got := policy.delay(1, resp, nil); got != 7*time.Second {
+    t.Errorf("delay = %v, want 7s", got)
+  }
+}
@@ -0,0 +1,488 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+)
+
+// RolesService handles roles related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles
+type RolesService service
+
+// RoleSettingKey is the key of a role setting.
+type RoleSettingKey string
+
+const (
+  RoleSettingDealDefaultVisibility    RoleSettingKey = "deal_default_visibility"
+  RoleSettingLeadDefaultVisibility    RoleSettingKey = "lead_default_visibility"
+  RoleSettingOrgDefaultVisibility     RoleSettingKey = "org_default_visibility"
+  RoleSettingPersonDefaultVisibility  RoleSettingKey = "person_default_visibility"
+  RoleSettingProductDefaultVisibility RoleSettingKey = "product_default_visibility"
+)
+
+// Role represents a Pipedrive role.
+type Role struct {
+  ID              int    `json:"id"`
+  ParentRoleID    *int   `json:"parent_role_id"`
+  Name            string `json:"name"`
+  ActiveFlag      bool   `json:"active_flag"`
+  AssignmentCount string `json:"assignment_count"`
+  SubRoleCount    string `json:"sub_role_count"`
+  Level           int    `json:"level"`
+}
+
+func (r Role) String() string {
+  return Stringify(r)
+}
+
+// RoleAssignment represents a user assigned to a role.
+type RoleAssignment struct {
+  UserID       int    `json:"user_id"`
+  RoleID       int    `json:"role_id"`
+  ParentRoleID *int   `json:"parent_role_id"`
+  Name         string `json:"name"`
+  ActiveFlag   bool   `json:"active_flag"`
+  Type         string `json:"type"`
+}
+
+func (r RoleAssignment) String() string {
+  return Stringify(r)
+}
+
+// RoleSettings represents the visibility settings of a role.
+type RoleSettings struct {
+  DealDefaultVisibility    int `json:"deal_default_visibility"`
+  LeadDefaultVisibility    int `json:"lead_default_visibility"`
+  OrgDefaultVisibility     int `json:"org_default_visibility"`
+  PersonDefaultVisibility  int `json:"person_default_visibility"`
+  ProductDefaultVisibility int `json:"product_default_visibility"`
+}
+
+// RolePipelines represents the pipelines visible, or hidden, to a role.
+type RolePipelines struct {
+  PipelineIDs []int `json:"pipeline_ids"`
+  Visible     bool  `json:"visible"`
+}
+
+// RolesResponse represents multiple roles response.
+type RolesResponse struct {
+  Success        bool           `json:"success"`
+  Data           []Role         `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// RoleResponse represents single role response.
+type RoleResponse struct {
+  Success bool `json:"success"`
+  Data    Role `json:"data"`
+}
+
+// RoleDeleteResponse represents role delete response.
+type RoleDeleteResponse struct {
+  Success bool `json:"success"`
+  Data    struct {
+    ID int `json:"id"`
+  } `json:"data"`
+}
+
+// RoleAssignmentsResponse represents multiple role assignments response.
+type RoleAssignmentsResponse struct {
+  Success        bool             `json:"success"`
+  Data           []RoleAssignment `json:"data"`
+  AdditionalData AdditionalData   `json:"additional_data"`
+}
+
+// RoleAssignmentResponse represents single role assignment response.
+type RoleAssignmentResponse struct {
+  Success bool           `json:"success"`
+  Data    RoleAssignment `json:"data"`
+}
+
+// RoleSettingsResponse represents role settings response.
+type RoleSettingsResponse struct {
+  Success bool         `json:"success"`
+  Data    RoleSettings `json:"data"`
+}
+
+// RoleSettingResponse represents role setting update response.
+type RoleSettingResponse struct {
+  Success bool `json:"success"`
+  Data    struct {
+    ID         int            `json:"id"`
+    SettingKey RoleSettingKey `json:"setting_key"`
+    Value      int            `json:"value"`
+  } `json:"data"`
+}
+
+// RolePipelinesResponse represents role pipelines response.
+type RolePipelinesResponse struct {
+  Success bool          `json:"success"`
+  Data    RolePipelines `json:"data"`
+}
+
+// List all roles within the company.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#getRoles
+func (s *RolesService) List(ctx context.Context, opt *ListOptions) (*RolesResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/roles", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RolesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAll returns an iterator over all roles within the company.
+func (s *RolesService) ListAll(ctx context.Context, opt *ListOptions) *Iterator[Role] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Role, *AdditionalData, *Response, error) {
+    record, resp, err := s.List(ctx, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// Get a specific role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#getRole
+func (s *RolesService) Get(ctx context.Context, id int) (*RoleResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RoleResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// RoleOptions specifices the optional parameters to the
+// RolesService.Create and RolesService.Update methods.
+type RoleOptions struct {
+  Name string `json:"name,omitempty"`
+
+  // Role the new role is placed under. Top level roles have none.
+  ParentRoleID int `json:"parent_role_id,omitempty"`
+}
+
+// Create a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#addRole
+func (s *RolesService) Create(ctx context.Context, opt *RoleOptions) (*RoleResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/roles", nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RoleResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Update a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#updateRole
+func (s *RolesService) Update(ctx context.Context, id int, opt *RoleOptions) (*RoleResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RoleResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// Delete a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#deleteRole
+func (s *RolesService) Delete(ctx context.Context, id int) (*RoleDeleteResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RoleDeleteResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListSubRoles lists the roles placed directly under a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#getRoleSubRoles
+func (s *RolesService) ListSubRoles(ctx context.Context, id int, opt *ListOptions) (*RolesResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v/roles", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RolesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllSubRoles returns an iterator over all roles placed directly under
+// a role.
+func (s *RolesService) ListAllSubRoles(ctx context.Context, id int, opt *ListOptions) *Iterator[Role] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]Role, *AdditionalData, *Response, error) {
+    record, resp, err := s.ListSubRoles(ctx, id, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// ListAssignments lists the users assigned to a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#getRoleAssignments
+func (s *RolesService) ListAssignments(ctx context.Context, id int, opt *ListOptions) (*RoleAssignmentsResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v/assignments", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RoleAssignmentsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAllAssignments returns an iterator over all users assigned to a role.
+func (s *RolesService) ListAllAssignments(ctx context.Context, id int, opt *ListOptions) *Iterator[RoleAssignment] {
+  return newIterator(ctx, opt, func(ctx context.Context, page *ListOptions) ([]RoleAssignment, *AdditionalData, *Response, error) {
+    record, resp, err := s.ListAssignments(ctx, id, page)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// AddAssignment assigns a user to a role. A user has a single role, so
+// this replaces the role the user had.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#addRoleAssignment
+func (s *RolesService) AddAssignment(ctx context.Context, id int, userID int) (*RoleAssignmentResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v/assignments", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, struct {
+    UserID int `json:"user_id"`
+  }{
+    userID,
+  })
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RoleAssignmentResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// DeleteAssignment removes a user from a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#deleteRoleAssignment
+func (s *RolesService) DeleteAssignment(ctx context.Context, id int, userID int) (*RoleAssignmentResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v/assignments", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, struct {
+    UserID int `json:"user_id"`
+  }{
+    userID,
+  })
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RoleAssignmentResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListSettings lists the visibility settings of a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#getRoleSettings
+func (s *RolesService) ListSettings(ctx context.Context, id int) (*RoleSettingsResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v/settings", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RoleSettingsResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// AddSetting adds or updates a visibility setting of a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#addOrUpdateRoleSetting
+func (s *RolesService) AddSetting(ctx context.Context, id int, key RoleSettingKey, value int) (*RoleSettingResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v/settings", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, struct {
+    SettingKey RoleSettingKey `json:"setting_key"`
+    Value      int            `json:"value"`
+  }{
+    key,
+    value,
+  })
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RoleSettingResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// RolePipelinesListOptions specifices the optional parameters to the
+// RolesService.ListPipelines method.
+type RolePipelinesListOptions struct {
+  // Lists the visible pipelines when true, the hidden ones otherwise.
+  Visible bool `url:"visible"`
+}
+
+// ListPipelines lists the pipelines visible, or hidden, to a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#getRolePipelines
+func (s *RolesService) ListPipelines(ctx context.Context, id int, opt *RolePipelinesListOptions) (*RolePipelinesResponse, *Response, error) {
+  uri := fmt.Sprintf("/roles/%v/pipelines", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RolePipelinesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// UpdatePipelines sets the pipelines visible to a role. The other
+// pipelines are hidden from it.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Roles#updateRolePipelines
+func (s *RolesService) UpdatePipelines(ctx context.Context, id int, visiblePipelineIDs []int) (*RolePipelinesResponse, *Response, error) {
+  if visiblePipelineIDs == nil {
+    visiblePipelineIDs = []int{}
+  }
+
+  uri := fmt.Sprintf("/roles/%v/pipelines", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, struct {
+    VisiblePipelineIDs []int `json:"visible_pipeline_ids"`
+  }{
+    visiblePipelineIDs,
+  })
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *RolePipelinesResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
@@ -0,0 +1,41 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "testing"
+)
+
+func TestRolesService_UpdatePipelines(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/roles/3/pipelines", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodPut {
+      t.Errorf("method = %v, want PUT", r.Method)
+    }
+
+    var body struct {
+      VisiblePipelineIDs []int `json:"visible_pipeline_ids"`
+    }
+
+    json.NewDecoder(r.Body).Decode(&body)
+
+    if len(body.VisiblePipelineIDs) != 2 || body.VisiblePipelineIDs[1] != 5 {
+      t.Errorf("body = %+v", body)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":{"pipeline_ids":[1,5],"visible":true}}`)
+  })
+
+  record, _, err := client.Roles.UpdatePipelines(context.Background(), 3, []int{1, 5})
+
+  if err != nil {
+    t.Fatalf("UpdatePipelines returned error: %v", err)
+  }
+
+  if !record.Data.Visible || len(record.Data.PipelineIDs) != 2 {
+    t.Errorf("UpdatePipelines returned %+v", record.Data)
+  }
+}
@@ -61,7 +61,7 @@ type SearchResultsListOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/SearchResults/get_searchResults
 func (s *SearchResultsService) Search(ctx context.Context, opt *SearchResultsListOptions) (*SearchResults, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/searchResults", opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/searchResults", opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -60,7 +60,7 @@ type StagesListOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages
 func (s *StagesService) List(ctx context.Context, opt *StagesListOptions) (*StagesResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/stages", opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/stages", opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -81,7 +81,7 @@ func (s *StagesService) List(ctx context.Context, opt *StagesListOptions) (*Stag
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/get_stages_id
 func (s *StagesService) GetByID(ctx context.Context, id int) (*StageResponse, *Response, error) {
   uri := fmt.Sprintf("/stages/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -113,7 +113,7 @@ type StagesGetDealsInStageOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/get_stages_id_deals
 func (s *StagesService) GetDealsInStage(ctx context.Context, id int, opt *StagesGetDealsInStageOptions) (*StageDealsResponse, *Response, error) {
   uri := fmt.Sprintf("/stages/%v/deals", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -144,7 +144,7 @@ type StagesCreateOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/post_stages
 func (s *StagesService) Create(ctx context.Context, opt *StagesCreateOptions) (*StageResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/stages", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/stages", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -177,7 +177,7 @@ type StagesUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/put_stages_id
 func (s *StagesService) Update(ctx context.Context, id int, opt *StagesUpdateOptions) (*StageResponse, *Response, error) {
   uri := fmt.Sprintf("/stages/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -198,7 +198,7 @@ func (s *StagesService) Update(ctx context.Context, id int, opt *StagesUpdateOpt
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/put_stages_id
 func (s *StagesService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-  req, err := s.client.NewRequest(http.MethodDelete, "/stages", &DeleteMultipleOptions{
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, "/stages", &DeleteMultipleOptions{
     Ids: arrayToString(ids, ","),
   }, nil)
 
@@ -214,7 +214,7 @@ func (s *StagesService) DeleteMultiple(ctx context.Context, ids []int) (*Respons
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/delete_stages_id
 func (s *StagesService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/stages/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err
@@ -0,0 +1,265 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+)
+
+// TasksService handles project tasks related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Tasks
+type TasksService service
+
+// Task represents a task of a Pipedrive project.
+type Task struct {
+  ID               int     `json:"id"`
+  Title            string  `json:"title"`
+  Description      string  `json:"description"`
+  ProjectID        int     `json:"project_id"`
+  ParentTaskID     *int    `json:"parent_task_id"`
+  AssigneeID       *int    `json:"assignee_id"`
+  CreatorID        int     `json:"creator_id"`
+  Done             uint8   `json:"done"`
+  DueDate          *string `json:"due_date"`
+  AddTime          string  `json:"add_time"`
+  UpdateTime       string  `json:"update_time"`
+  MarkedAsDoneTime *string `json:"marked_as_done_time"`
+}
+
+func (t Task) String() string {
+  return Stringify(t)
+}
+
+// TasksResponse represents multiple tasks response.
+type TasksResponse struct {
+  Success        bool           `json:"success"`
+  Data           []Task         `json:"data"`
+  AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// TaskResponse represents single task response.
+type TaskResponse struct {
+  Success bool `json:"success"`
+  Data    Task `json:"data"`
+}
+
+// TasksListOptions specifices the optional parameters to the
+// TasksService.List method. Tasks are paginated by cursor.
+type TasksListOptions struct {
+  AssigneeID uint `url:"assignee_id,omitempty"`
+  ProjectID  uint `url:"project_id,omitempty"`
+
+  // Lists the subtasks of a task. Top level tasks are listed when set to
+  // "null".
+  ParentTaskID string `url:"parent_task_id,omitempty"`
+
+  // 0 for tasks to do, 1 for done ones. All are returned when nil.
+  Done *uint8 `url:"done,omitempty"`
+
+  ListOptions
+}
+
+// List tasks.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Tasks#getTasks
+func (s *TasksService) List(ctx context.Context, opt *TasksListOptions) (*TasksResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/tasks", opt, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *TasksResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// ListAll returns an iterator over all tasks matching opt.
+func (s *TasksService) ListAll(ctx context.Context, opt *TasksListOptions) *Iterator[Task] {
+  var filters TasksListOptions
+
+  if opt != nil {
+    filters = *opt
+  }
+
+  return newIterator(ctx, &filters.ListOptions, func(ctx context.Context, page *ListOptions) ([]Task, *AdditionalData, *Response, error) {
+    query := filters
+    query.ListOptions = *page
+
+    record, resp, err := s.List(ctx, &query)
+
+    if err != nil || record == nil {
+      return nil, nil, resp, err
+    }
+
+    return record.Data, &record.AdditionalData, resp, nil
+  })
+}
+
+// ListSubtasks returns an iterator over the subtasks of a task.
+func (s *TasksService) ListSubtasks(ctx context.Context, id int, opt *ListOptions) *Iterator[Task] {
+  filters := &TasksListOptions{ParentTaskID: fmt.Sprint(id)}
+
+  if opt != nil {
+    filters.ListOptions = *opt
+  }
+
+  return s.ListAll(ctx, filters)
+}
+
+// Get a specific task.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Tasks#getTask
+func (s *TasksService) Get(ctx context.Context, id int) (*TaskResponse, *Response, error) {
+  uri := fmt.Sprintf("/tasks/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *TaskResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// TaskCreateOptions specifices the parameters to the TasksService.Create
+// method. Title and ProjectID are required.
+type TaskCreateOptions struct {
+  Title       string `json:"title"`
+  ProjectID   int    `json:"project_id"`
+  Description string `json:"description,omitempty"`
+
+  // Task the new task is a subtask of. Subtasks can not be nested.
+  ParentTaskID int   `json:"parent_task_id,omitempty"`
+  AssigneeID   int   `json:"assignee_id,omitempty"`
+  Done         uint8 `json:"done,omitempty"`
+
+  // Formatted as YYYY-MM-DD.
+  DueDate string `json:"due_date,omitempty"`
+}
+
+// Create a task.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Tasks#addTask
+func (s *TasksService) Create(ctx context.Context, opt *TaskCreateOptions) (*TaskResponse, *Response, error) {
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/tasks", nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *TaskResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// AddSubtask creates a subtask of a task, in the project of that task.
+func (s *TasksService) AddSubtask(ctx context.Context, id int, opt *TaskCreateOptions) (*TaskResponse, *Response, error) {
+  subtask := TaskCreateOptions{}
+
+  if opt != nil {
+    subtask = *opt
+  }
+
+  subtask.ParentTaskID = id
+
+  if subtask.ProjectID == 0 {
+    parent, resp, err := s.Get(ctx, id)
+
+    if err != nil {
+      return nil, resp, err
+    }
+
+    if parent == nil {
+      return nil, resp, fmt.Errorf("parent task %v not found", id)
+    }
+
+    subtask.ProjectID = parent.Data.ProjectID
+  }
+
+  return s.Create(ctx, &subtask)
+}
+
+// TaskUpdateOptions specifices the optional parameters to the
+// TasksService.Update method.
+type TaskUpdateOptions struct {
+  Title        string `json:"title,omitempty"`
+  ProjectID    int    `json:"project_id,omitempty"`
+  Description  string `json:"description,omitempty"`
+  ParentTaskID int    `json:"parent_task_id,omitempty"`
+  AssigneeID   int    `json:"assignee_id,omitempty"`
+
+  // 0 for a task to do, 1 for a done one. Left unchanged when nil.
+  Done *uint8 `json:"done,omitempty"`
+
+  // Formatted as YYYY-MM-DD.
+  DueDate string `json:"due_date,omitempty"`
+}
+
+// Update a task.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Tasks#updateTask
+func (s *TasksService) Update(ctx context.Context, id int, opt *TaskUpdateOptions) (*TaskResponse, *Response, error) {
+  uri := fmt.Sprintf("/tasks/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
+
+  if err != nil {
+    return nil, nil, err
+  }
+
+  var record *TaskResponse
+
+  resp, err := s.client.Do(ctx, req, &record)
+
+  if err != nil {
+    return nil, resp, err
+  }
+
+  return record, resp, nil
+}
+
+// SetDone marks a task as done, or as to do again.
+func (s *TasksService) SetDone(ctx context.Context, id int, done bool) (*TaskResponse, *Response, error) {
+  var flag uint8
+
+  if done {
+    flag = 1
+  }
+
+  return s.Update(ctx, id, &TaskUpdateOptions{Done: &flag})
+}
+
+// Delete a task. Its subtasks are deleted too.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/Tasks#deleteTask
+func (s *TasksService) Delete(ctx context.Context, id int) (*Response, error) {
+  uri := fmt.Sprintf("/tasks/%v", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
+
+  if err != nil {
+    return nil, err
+  }
+
+  return s.client.Do(ctx, req, nil)
+}
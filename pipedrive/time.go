@@ -1,7 +1,10 @@
 package pipedrive
 
 // http://fuckinggodateformat.com/
-import "time"
+import (
+  "encoding/json"
+  "time"
+)
 
 type Timestamp struct {
   time.Time
@@ -18,3 +21,39 @@ func (t Timestamp) Format() string {
 func (t Timestamp) FormatFull() string {
   return t.Time.Format("2006-01-02 15 04 05")
 }
+
+// Layouts of the timestamps returned by Pipedrive, tried in order.
+var timestampLayouts = []string{
+  "2006-01-02 15:04:05",
+  time.RFC3339,
+  "2006-01-02",
+}
+
+// UnmarshalJSON parses the "YYYY-MM-DD HH:MM:SS" timestamps used by
+// Pipedrive, as well as RFC 3339 and plain dates. Null and empty strings
+// leave the timestamp zero.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+  var s *string
+
+  if err := json.Unmarshal(data, &s); err != nil {
+    return err
+  }
+
+  if s == nil || *s == "" {
+    t.Time = time.Time{}
+    return nil
+  }
+
+  var err error
+
+  for _, layout := range timestampLayouts {
+    var parsed time.Time
+
+    if parsed, err = time.Parse(layout, *s); err == nil {
+      t.Time = parsed
+      return nil
+    }
+  }
+
+  return err
+}
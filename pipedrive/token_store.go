@@ -0,0 +1,145 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "errors"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "sync"
+)
+
+// ErrTokenNotFound is returned by a TokenStore that holds no token yet.
+var ErrTokenNotFound = errors.New("pipedrive: token not found")
+
+// TokenStore persists OAuth tokens, so that refreshed tokens can be shared
+// between client instances.
+type TokenStore interface {
+  // Load returns the stored token or ErrTokenNotFound.
+  Load(ctx context.Context) (*Token, error)
+
+  // Save replaces the stored token.
+  Save(ctx context.Context, token *Token) error
+}
+
+// MemoryTokenStore keeps a token in memory.
+type MemoryTokenStore struct {
+  mu    sync.Mutex
+  token *Token
+}
+
+// NewMemoryTokenStore returns a store holding token, which may be nil.
+func NewMemoryTokenStore(token *Token) *MemoryTokenStore {
+  return &MemoryTokenStore{token: token}
+}
+
+// Load returns the stored token.
+func (s *MemoryTokenStore) Load(ctx context.Context) (*Token, error) {
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  if s.token == nil {
+    return nil, ErrTokenNotFound
+  }
+
+  token := *s.token
+
+  return &token, nil
+}
+
+// Save replaces the stored token.
+func (s *MemoryTokenStore) Save(ctx context.Context, token *Token) error {
+  if token == nil {
+    return errors.New("token must not be nil")
+  }
+
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  stored := *token
+  s.token = &stored
+
+  return nil
+}
+
+// FileTokenStore keeps a token as JSON in a file. Writes replace the file
+// atomically, so several processes can share it.
+type FileTokenStore struct {
+  Path string
+
+  mu sync.Mutex
+}
+
+// NewFileTokenStore returns a store backed by the file at path.
+func NewFileTokenStore(path string) *FileTokenStore {
+  return &FileTokenStore{Path: path}
+}
+
+// Load reads the token from the file.
+func (s *FileTokenStore) Load(ctx context.Context) (*Token, error) {
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  data, err := ioutil.ReadFile(s.Path)
+
+  if os.IsNotExist(err) {
+    return nil, ErrTokenNotFound
+  }
+
+  if err != nil {
+    return nil, err
+  }
+
+  var token *Token
+
+  if err := json.Unmarshal(data, &token); err != nil {
+    return nil, err
+  }
+
+  if token == nil {
+    return nil, ErrTokenNotFound
+  }
+
+  return token, nil
+}
+
+// Save writes the token to the file.
+func (s *FileTokenStore) Save(ctx context.Context, token *Token) error {
+  if token == nil {
+    return errors.New("token must not be nil")
+  }
+
+  data, err := json.Marshal(token)
+
+  if err != nil {
+    return err
+  }
+
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  tmp, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+
+  if err != nil {
+    return err
+  }
+
+  defer os.Remove(tmp.Name())
+
+  if err := tmp.Chmod(0600); err != nil {
+    tmp.Close()
+    return err
+  }
+
+  if _, err := tmp.Write(data); err != nil {
+    tmp.Close()
+    return err
+  }
+
+  if err := tmp.Close(); err != nil {
+    return err
+  }
+
+  return os.Rename(tmp.Name(), s.Path)
+}
@@ -23,7 +23,7 @@ type UserConnections struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/UserConnections/get_userConnections
 func (s *UserConnectionsService) List(ctx context.Context) (*UserConnections, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/userConnections", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/userConnections", nil, nil)
 
   if err != nil {
     return nil, nil, err
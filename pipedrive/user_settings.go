@@ -126,7 +126,7 @@ type UserSettings struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/UserSettings/get_userSettings
 func (s *UserSettingsService) List(ctx context.Context) (*UserSettings, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/userSettings", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/userSettings", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -4,6 +4,7 @@ import (
   "context"
   "fmt"
   "net/http"
+  "strings"
 )
 
 // UsersService handles users related
@@ -126,7 +127,7 @@ type Language struct {
 // https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id_followers
 func (s *UsersService) ListFollowers(ctx context.Context, id int) (*UserFollowersResponse, *Response, error) {
   uri := fmt.Sprintf("/users/%v/followers", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -147,7 +148,7 @@ func (s *UsersService) ListFollowers(ctx context.Context, id int) (*UserFollower
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users
 func (s *UsersService) List(ctx context.Context) (*UsersResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/users", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/users", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -167,16 +168,19 @@ func (s *UsersService) List(ctx context.Context) (*UsersResponse, *Response, err
 // UserCreateOptions specifices the optional parameters to the
 // UsersService.Create method.
 type UserCreateOptions struct {
-  Name       string `url:"name"`
-  Email      string `url:"email"`
-  ActiveFlag uint8  `url:"active_flag"`
+  Name       string `json:"name"`
+  Email      string `json:"email"`
+  ActiveFlag uint8  `json:"active_flag"`
+
+  // Permission sets granted to the user, one per app.
+  Access []Access `json:"access,omitempty"`
 }
 
 // Create a user.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/post_users
 func (s *UsersService) Create(ctx context.Context, opt *UserCreateOptions) (*UserSingleResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/users", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/users", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -204,7 +208,7 @@ type UsersFindByNameOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_find
 func (s *UsersService) FindByName(ctx context.Context, opt *UsersFindByNameOptions) (*UsersResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/users/find", opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/users/find", opt, nil)
 
   if err != nil {
     return nil, nil, err
@@ -225,7 +229,7 @@ func (s *UsersService) FindByName(ctx context.Context, opt *UsersFindByNameOptio
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_me
 func (s *UsersService) GetCurrentUserData(ctx context.Context) (*UserSingleResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/users/me", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/users/me", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -247,7 +251,7 @@ func (s *UsersService) GetCurrentUserData(ctx context.Context) (*UserSingleRespo
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id
 func (s *UsersService) GetByID(ctx context.Context, id int) (*UserFollowersResponse, *Response, error) {
   uri := fmt.Sprintf("/users/%v", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -269,7 +273,7 @@ func (s *UsersService) GetByID(ctx context.Context, id int) (*UserFollowersRespo
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id_permissions
 func (s *UsersService) ListUserPermissions(ctx context.Context, id int) (*UserPermissionsResponse, *Response, error) {
   uri := fmt.Sprintf("/users/%v/permissions", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -291,7 +295,7 @@ func (s *UsersService) ListUserPermissions(ctx context.Context, id int) (*UserPe
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id_roleSettings
 func (s *UsersService) ListUserRoleSettings(ctx context.Context, id int) (*UserRoleSettingsResponse, *Response, error) {
   uri := fmt.Sprintf("/users/%v/roleSettings", id)
-  req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, uri, nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -311,7 +315,7 @@ func (s *UsersService) ListUserRoleSettings(ctx context.Context, id int) (*UserR
 // UsersUpdateUserDetailsOptions specifices the optional parameters to the
 // UsersService.UpdateUserDetails method.
 type UsersUpdateUserDetailsOptions struct {
-  ActiveFlag uint8 `url:"active_flag,omitempty"`
+  ActiveFlag uint8 `json:"active_flag"`
 }
 
 // UpdateUserDetails updates the properties of a user. Currently, only active_flag can be updated.
@@ -319,7 +323,7 @@ type UsersUpdateUserDetailsOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/put_users_id
 func (s *UsersService) UpdateUserDetails(ctx context.Context, id int, opt *UsersUpdateUserDetailsOptions) (*Response, error) {
   uri := fmt.Sprintf("/users/%v", id)
-  req, err := s.client.NewRequest(http.MethodPut, uri, opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, uri, nil, opt)
 
   if err != nil {
     return nil, err
@@ -334,6 +338,25 @@ func (s *UsersService) UpdateUserDetails(ctx context.Context, id int, opt *Users
   return resp, nil
 }
 
+// AddPermissionSetAssignment grants a permission set to a user. It replaces
+// the permission set the user had for the same app.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/post_users_id_permissionSetAssignments
+func (s *UsersService) AddPermissionSetAssignment(ctx context.Context, id int, permissionSetID string) (*Response, error) {
+  uri := fmt.Sprintf("/users/%v/permissionSetAssignments", id)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, uri, nil, struct {
+    PermissionSetID string `json:"permission_set_id"`
+  }{
+    permissionSetID,
+  })
+
+  if err != nil {
+    return nil, err
+  }
+
+  return s.client.Do(ctx, req, nil)
+}
+
 // DeletePermissionSetAssignmentOptions specifices the optional parameters to the
 // UsersService.DeletePermissionSetAssignment method.
 type DeletePermissionSetAssignmentOptions struct {
@@ -345,7 +368,7 @@ type DeletePermissionSetAssignmentOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/delete_users_id_permissionSetAssignments
 func (s *UsersService) DeletePermissionSetAssignment(ctx context.Context, id int, opt *DeletePermissionSetAssignmentOptions) (*Response, error) {
   uri := fmt.Sprintf("/users/%v/permissionSetAssignments", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, opt, nil)
 
   if err != nil {
     return nil, err
@@ -371,7 +394,7 @@ type DeleteRoleAssignmentOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/delete_users_id_roleAssignments
 func (s *UsersService) DeleteRoleAssignment(ctx context.Context, id int, opt *DeleteRoleAssignmentOptions) (*Response, error) {
   uri := fmt.Sprintf("/users/%v/roleAssignments", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, opt, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, opt, nil)
 
   if err != nil {
     return nil, err
@@ -385,3 +408,184 @@ func (s *UsersService) DeleteRoleAssignment(ctx context.Context, id int, opt *De
 
   return resp, nil
 }
+
+// UserProvisionOptions specifices the parameters to the
+// UsersService.Provision method. Email is required.
+type UserProvisionOptions struct {
+  Email string
+
+  // Name given to the user when it is created. Pipedrive does not allow
+  // renaming users through the API.
+  Name string
+
+  // Role assigned to the user. Left unchanged when zero.
+  RoleID int
+
+  // Permission set granted to the user. Left unchanged when empty.
+  PermissionSetID string
+
+  // Deactivates the user instead of activating it.
+  Inactive bool
+}
+
+// UserProvisionResult describes the user provisioned by
+// UsersService.Provision and the changes made to it.
+type UserProvisionResult struct {
+  User User
+
+  Created              bool
+  ActiveFlagChanged    bool
+  RoleAssigned         bool
+  PermissionSetGranted bool
+}
+
+// Provision creates or updates the user with the given email so that it
+// has the requested active flag, role and permission set. Only what differs
+// is changed, so provisioning the same user twice makes no changes.
+func (s *UsersService) Provision(ctx context.Context, opt *UserProvisionOptions) (*UserProvisionResult, error) {
+  if opt == nil || opt.Email == "" {
+    return nil, fmt.Errorf("provisioning a user requires an email")
+  }
+
+  user, err := s.findByEmail(ctx, opt.Email)
+
+  if err != nil {
+    return nil, err
+  }
+
+  result := &UserProvisionResult{}
+
+  if user == nil {
+    user, err = s.provisionCreate(ctx, opt)
+
+    if err != nil {
+      return nil, err
+    }
+
+    result.Created = true
+  } else if user.ActiveFlag == opt.Inactive {
+    var activeFlag uint8
+
+    if !opt.Inactive {
+      activeFlag = 1
+    }
+
+    _, err := s.UpdateUserDetails(ctx, user.ID, &UsersUpdateUserDetailsOptions{ActiveFlag: activeFlag})
+
+    if err != nil {
+      return nil, fmt.Errorf("updating user %v: %w", user.ID, err)
+    }
+
+    user.ActiveFlag = !opt.Inactive
+    result.ActiveFlagChanged = true
+  }
+
+  if opt.PermissionSetID != "" && !user.hasPermissionSet(opt.PermissionSetID) {
+    _, err := s.AddPermissionSetAssignment(ctx, user.ID, opt.PermissionSetID)
+
+    if err != nil {
+      return nil, fmt.Errorf("granting permission set %v to user %v: %w", opt.PermissionSetID, user.ID, err)
+    }
+
+    user.Access = append(user.Access, Access{PermissionSetID: opt.PermissionSetID})
+    result.PermissionSetGranted = true
+  }
+
+  if opt.RoleID != 0 && user.RoleID != opt.RoleID {
+    _, _, err := s.client.Roles.AddAssignment(ctx, opt.RoleID, user.ID)
+
+    if err != nil {
+      return nil, fmt.Errorf("assigning role %v to user %v: %w", opt.RoleID, user.ID, err)
+    }
+
+    user.RoleID = opt.RoleID
+    result.RoleAssigned = true
+  }
+
+  result.User = *user
+
+  return result, nil
+}
+
+// findByEmail returns the user whose email is email, or nil when there is
+// none. The search matches partial emails, so results are filtered.
+func (s *UsersService) findByEmail(ctx context.Context, email string) (*User, error) {
+  record, _, err := s.FindByName(ctx, &UsersFindByNameOptions{
+    Term:          email,
+    SearchByEmail: 1,
+  })
+
+  if err != nil {
+    return nil, fmt.Errorf("finding user %v: %w", email, err)
+  }
+
+  if record == nil {
+    return nil, nil
+  }
+
+  for i := range record.Data {
+    if strings.EqualFold(record.Data[i].Email, email) {
+      return &record.Data[i], nil
+    }
+  }
+
+  return nil, nil
+}
+
+// provisionCreate creates the user described by opt. The permission set is
+// granted by the create request itself, after looking up its app.
+func (s *UsersService) provisionCreate(ctx context.Context, opt *UserProvisionOptions) (*User, error) {
+  create := &UserCreateOptions{
+    Name:  opt.Name,
+    Email: opt.Email,
+  }
+
+  if !opt.Inactive {
+    create.ActiveFlag = 1
+  }
+
+  if opt.PermissionSetID != "" {
+    set, _, err := s.client.PermissionSets.Get(ctx, opt.PermissionSetID)
+
+    if err != nil {
+      return nil, fmt.Errorf("getting permission set %v: %w", opt.PermissionSetID, err)
+    }
+
+    if set == nil {
+      return nil, fmt.Errorf("permission set %v not found", opt.PermissionSetID)
+    }
+
+    create.Access = []Access{{
+      App:             string(set.Data.App),
+      PermissionSetID: opt.PermissionSetID,
+    }}
+  }
+
+  record, _, err := s.Create(ctx, create)
+
+  if err != nil {
+    return nil, fmt.Errorf("creating user %v: %w", opt.Email, err)
+  }
+
+  if record == nil {
+    return nil, fmt.Errorf("creating user %v: empty response", opt.Email)
+  }
+
+  user := record.Data
+
+  if len(user.Access) == 0 {
+    user.Access = create.Access
+  }
+
+  return &user, nil
+}
+
+func (u *User) hasPermissionSet(id string) bool {
+  for _, access := range u.Access {
+    if access.PermissionSetID == id {
+      return true
+    }
+  }
+
+  return false
+}
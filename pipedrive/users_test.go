@@ -0,0 +1,119 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "testing"
+)
+
+func TestUsersService_Provision_create(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/users/find", func(w http.ResponseWriter, r *http.Request) {
+    if got := r.URL.Query().Get("search_by_email"); got != "1" {
+      t.Errorf("search_by_email = %q, want 1", got)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":[{"id":4,"email":"jane.doe@example.com"}]}`)
+  })
+
+  mux.HandleFunc("/permissionSets/ps-1", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":{"id":"ps-1","app":"sales"}}`)
+  })
+
+  mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+    var body UserCreateOptions
+    json.NewDecoder(r.Body).Decode(&body)
+
+    if body.Email != "jane@example.com" || body.ActiveFlag != 1 || len(body.Access) != 1 || body.Access[0].App != "sales" {
+      t.Errorf("body = %+v", body)
+    }
+
+    fmt.Fprint(w, `{"success":true,"data":{"id":9,"email":"jane@example.com","active_flag":true,"role_id":1,
+      "access":[{"app":"sales","permission_set_id":"ps-1"}]}}`)
+  })
+
+  mux.HandleFunc("/roles/2/assignments", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":{"user_id":9,"role_id":2}}`)
+  })
+
+  result, err := client.Users.Provision(context.Background(), &UserProvisionOptions{
+    Email:           "jane@example.com",
+    Name:            "Jane",
+    RoleID:          2,
+    PermissionSetID: "ps-1",
+  })
+
+  if err != nil {
+    t.Fatalf("Provision returned error: %v", err)
+  }
+
+  if !result.Created || !result.RoleAssigned || result.PermissionSetGranted || result.User.RoleID != 2 {
+    t.Errorf("Provision returned %+v", result)
+  }
+}
+
+func TestUsersService_Provision_unchanged(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/users/find", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":[{"id":9,"email":"Jane@Example.com","active_flag":true,"role_id":2,
+      "access":[{"app":"sales","permission_set_id":"ps-1"}]}]}`)
+  })
+
+  mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+    t.Errorf("unexpected request %v %v", r.Method, r.URL.Path)
+  })
+
+  result, err := client.Users.Provision(context.Background(), &UserProvisionOptions{
+    Email:           "jane@example.com",
+    RoleID:          2,
+    PermissionSetID: "ps-1",
+  })
+
+  if err != nil {
+    t.Fatalf("Provision returned error: %v", err)
+  }
+
+  if result.Created || result.ActiveFlagChanged || result.RoleAssigned || result.PermissionSetGranted {
+    t.Errorf("Provision returned %+v", result)
+  }
+}
+
+func TestUsersService_Provision_deactivate(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  mux.HandleFunc("/users/find", func(w http.ResponseWriter, r *http.Request) {
+    fmt.Fprint(w, `{"success":true,"data":[{"id":9,"email":"jane@example.com","active_flag":true}]}`)
+  })
+
+  mux.HandleFunc("/users/9", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodPut {
+      t.Errorf("Request method = %v, want %v", r.Method, http.MethodPut)
+    }
+
+    var body map[string]interface{}
+    json.NewDecoder(r.Body).Decode(&body)
+
+    if got, ok := body["active_flag"]; !ok || got != float64(0) {
+      t.Errorf("body = %v, want active_flag 0", body)
+    }
+
+    fmt.Fprint(w, `{"success":true}`)
+  })
+
+  result, err := client.Users.Provision(context.Background(), &UserProvisionOptions{
+    Email:    "jane@example.com",
+    Inactive: true,
+  })
+
+  if err != nil {
+    t.Fatalf("Provision returned error: %v", err)
+  }
+
+  if !result.ActiveFlagChanged || result.User.ActiveFlag {
+    t.Errorf("Provision returned %+v", result)
+  }
+}
@@ -0,0 +1,430 @@
+package webhook
+
+import (
+  "bytes"
+  "encoding/json"
+  "errors"
+  "fmt"
+  "reflect"
+  "strconv"
+  "strings"
+  "time"
+
+  "github.com/dinistavares/pipedrive-api/pipedrive"
+)
+
+// ErrPayload is returned by ParseEvent for request bodies that are not
+// webhook events.
+var ErrPayload = errors.New("pipedrive: invalid webhook payload")
+
+// Event represents a change notified by a webhook, decoded from either
+// version of the payload.
+//
+// Current and Previous hold the item after and before the change. Current
+// is nil for deletions, and both are nil for objects without a
+// pipedrive.WebhookObject type, in which case RawCurrent and RawPrevious
+// hold the undecoded items. Version 2 payloads only include the changed
+// fields in Previous.
+//
+//   switch deal := event.Current.(type) {
+//   case pipedrive.Deal:
+//   }
+type Event struct {
+  // Unique ID of the event. Version 1 payloads have none, so it is derived
+  // from the webhook, the item and the time of the change.
+  ID string
+
+  Version pipedrive.WebhookVersion
+  Action  pipedrive.EventAction
+  Object  pipedrive.EventObject
+
+  // ID of the item the event is about.
+  ItemID int
+
+  CompanyID    int
+  UserID       int
+  WebhookID    string
+  Host         string
+  ChangeSource string
+  IsBulkUpdate bool
+
+  // Delivery attempt of the event, starting at 1.
+  Attempt int
+
+  Timestamp time.Time
+
+  Current  pipedrive.WebhookObject
+  Previous pipedrive.WebhookObject
+
+  RawCurrent  json.RawMessage
+  RawPrevious json.RawMessage
+  RawMeta     json.RawMessage
+}
+
+func (e Event) String() string {
+  return pipedrive.Stringify(e)
+}
+
+// rawEvent holds the fields of both versions of webhook payloads.
+// Version 1 payloads send the item as current, version 2 as data.
+type rawEvent struct {
+  Meta     json.RawMessage `json:"meta"`
+  Current  json.RawMessage `json:"current"`
+  Data     json.RawMessage `json:"data"`
+  Previous json.RawMessage `json:"previous"`
+  Retry    int             `json:"retry"`
+}
+
+type metaV1 struct {
+  V              int                   `json:"v"`
+  Action         pipedrive.EventAction `json:"action"`
+  Object         pipedrive.EventObject `json:"object"`
+  ID             idString              `json:"id"`
+  CompanyID      idString              `json:"company_id"`
+  UserID         idString              `json:"user_id"`
+  WebhookID      idString              `json:"webhook_id"`
+  Host           string                `json:"host"`
+  ChangeSource   string                `json:"change_source"`
+  IsBulkUpdate   bool                  `json:"is_bulk_update"`
+  Timestamp      int64                 `json:"timestamp"`
+  TimestampMicro int64                 `json:"timestamp_micro"`
+}
+
+type metaV2 struct {
+  ID           string                `json:"id"`
+  Version      string                `json:"version"`
+  Action       string                `json:"action"`
+  Entity       pipedrive.EventObject `json:"entity"`
+  EntityID     idString              `json:"entity_id"`
+  CompanyID    idString              `json:"company_id"`
+  UserID       idString              `json:"user_id"`
+  WebhookID    idString              `json:"webhook_id"`
+  Host         string                `json:"host"`
+  ChangeSource string                `json:"change_source"`
+  IsBulkEdit   bool                  `json:"is_bulk_edit"`
+  Attempt      int                   `json:"attempt"`
+  Timestamp    string                `json:"timestamp"`
+}
+
+// Actions of version 2 payloads, named after the version 1 ones.
+var actionsV2 = map[string]pipedrive.EventAction{
+  "create": pipedrive.ACTION_ADDED,
+  "change": pipedrive.ACTION_UPDATED,
+  "merge":  pipedrive.ACTION_MERGED,
+  "delete": pipedrive.ACTION_DELETED,
+}
+
+// idString decodes the IDs webhooks send either as strings or as numbers.
+type idString string
+
+func (s *idString) UnmarshalJSON(data []byte) error {
+  if len(data) > 0 && data[0] == '"' {
+    return json.Unmarshal(data, (*string)(s))
+  }
+
+  if raw := string(bytes.TrimSpace(data)); raw != "null" {
+    *s = idString(raw)
+  }
+
+  return nil
+}
+
+func (s idString) int() int {
+  n, _ := strconv.Atoi(string(s))
+
+  return n
+}
+
+// ParseEvent decodes the body of a webhook request of either version.
+func ParseEvent(data []byte) (*Event, error) {
+  var payload rawEvent
+
+  if err := json.Unmarshal(data, &payload); err != nil {
+    return nil, fmt.Errorf("%w: %v", ErrPayload, err)
+  }
+
+  var version struct {
+    V       int    `json:"v"`
+    Version string `json:"version"`
+  }
+
+  if err := json.Unmarshal(payload.Meta, &version); err != nil || len(payload.Meta) == 0 {
+    return nil, fmt.Errorf("%w: missing meta", ErrPayload)
+  }
+
+  var event *Event
+  var err error
+
+  switch {
+  case version.Version == string(pipedrive.WebhookVersion2):
+    event, err = parseEventV2(&payload)
+  case version.V == 1:
+    event, err = parseEventV1(&payload)
+  default:
+    return nil, fmt.Errorf("%w: unknown version", ErrPayload)
+  }
+
+  if err != nil {
+    return nil, err
+  }
+
+  event.RawMeta = payload.Meta
+
+  if event.Current, err = decodeObject(event.Object, event.RawCurrent); err != nil {
+    return nil, fmt.Errorf("%w: %v current: %v", ErrPayload, event.Object, err)
+  }
+
+  if event.Previous, err = decodeObject(event.Object, event.RawPrevious); err != nil {
+    return nil, fmt.Errorf("%w: %v previous: %v", ErrPayload, event.Object, err)
+  }
+
+  return event, nil
+}
+
+func parseEventV1(payload *rawEvent) (*Event, error) {
+  var meta metaV1
+
+  if err := json.Unmarshal(payload.Meta, &meta); err != nil {
+    return nil, fmt.Errorf("%w: %v", ErrPayload, err)
+  }
+
+  event := &Event{
+    Version:      pipedrive.WebhookVersion1,
+    Action:       meta.Action,
+    Object:       meta.Object,
+    ItemID:       meta.ID.int(),
+    CompanyID:    meta.CompanyID.int(),
+    UserID:       meta.UserID.int(),
+    WebhookID:    string(meta.WebhookID),
+    Host:         meta.Host,
+    ChangeSource: meta.ChangeSource,
+    IsBulkUpdate: meta.IsBulkUpdate,
+    Attempt:      payload.Retry + 1,
+    RawCurrent:   payload.Current,
+    RawPrevious:  payload.Previous,
+  }
+
+  if meta.TimestampMicro != 0 {
+    event.Timestamp = time.UnixMicro(meta.TimestampMicro).UTC()
+  } else if meta.Timestamp != 0 {
+    event.Timestamp = time.Unix(meta.Timestamp, 0).UTC()
+  }
+
+  event.ID = fmt.Sprintf("%v:%v:%v:%v:%v", meta.WebhookID, meta.Object, meta.Action, meta.ID, event.Timestamp.UnixMicro())
+
+  return event, nil
+}
+
+func parseEventV2(payload *rawEvent) (*Event, error) {
+  var meta metaV2
+
+  if err := json.Unmarshal(payload.Meta, &meta); err != nil {
+    return nil, fmt.Errorf("%w: %v", ErrPayload, err)
+  }
+
+  action, ok := actionsV2[meta.Action]
+
+  if !ok {
+    action = pipedrive.EventAction(meta.Action)
+  }
+
+  event := &Event{
+    ID:           meta.ID,
+    Version:      pipedrive.WebhookVersion2,
+    Action:       action,
+    Object:       meta.Entity,
+    ItemID:       meta.EntityID.int(),
+    CompanyID:    meta.CompanyID.int(),
+    UserID:       meta.UserID.int(),
+    WebhookID:    string(meta.WebhookID),
+    Host:         meta.Host,
+    ChangeSource: meta.ChangeSource,
+    IsBulkUpdate: meta.IsBulkEdit,
+    Attempt:      meta.Attempt,
+    RawCurrent:   payload.Data,
+    RawPrevious:  payload.Previous,
+  }
+
+  if meta.Timestamp != "" {
+    timestamp, err := time.Parse(time.RFC3339, meta.Timestamp)
+
+    if err != nil {
+      return nil, fmt.Errorf("%w: %v", ErrPayload, err)
+    }
+
+    event.Timestamp = timestamp
+  }
+
+  return event, nil
+}
+
+func decodeObject(object pipedrive.EventObject, data json.RawMessage) (pipedrive.WebhookObject, error) {
+  if len(data) == 0 || string(bytes.TrimSpace(data)) == "null" {
+    return nil, nil
+  }
+
+  switch object {
+  case pipedrive.OBJECT_DEAL:
+    return decodeItem[pipedrive.Deal](data)
+  case pipedrive.OBJECT_PERSON:
+    return decodeItem[pipedrive.Person](data)
+  case pipedrive.OBJECT_ORGANIZATION:
+    return decodeItem[pipedrive.Organization](data)
+  case pipedrive.OBJECT_ACTIVITY:
+    return decodeItem[pipedrive.Activity](data)
+  case pipedrive.OBJECT_NOTE:
+    return decodeItem[pipedrive.Note](data)
+  case pipedrive.OBJECT_PRODUCT:
+    return decodeItem[pipedrive.Product](data)
+  case pipedrive.OBJECT_PIPELINE:
+    return decodeItem[pipedrive.Pipeline](data)
+  case pipedrive.OBJECT_STAGE:
+    return decodeItem[pipedrive.Stage](data)
+  case pipedrive.OBJECT_USER:
+    return decodeItem[pipedrive.User](data)
+  }
+
+  return nil, nil
+}
+
+func decodeItem[T pipedrive.WebhookObject](data json.RawMessage) (pipedrive.WebhookObject, error) {
+  var item T
+
+  data, err := normalizeItem(data, reflect.TypeOf(item))
+
+  if err != nil {
+    return nil, err
+  }
+
+  if err := json.Unmarshal(data, &item); err != nil {
+    return nil, err
+  }
+
+  return item, nil
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// normalizeItem adapts a webhook item to the type t returned by the API. Webhooks send related items as bare IDs where the API embeds them,
+// numbers where the API sends strings and the reverse, and version 2
+// payloads group custom fields under custom_fields.
+func normalizeItem(data []byte, t reflect.Type) ([]byte, error) {
+  var fields map[string]json.RawMessage
+
+  if err := json.Unmarshal(data, &fields); err != nil {
+    return nil, err
+  }
+
+  var custom map[string]json.RawMessage
+
+  if err := json.Unmarshal(fields["custom_fields"], &custom); err == nil && custom != nil {
+    delete(fields, "custom_fields")
+
+    for key, value := range custom {
+      if _, ok := fields[key]; !ok {
+        fields[key] = customFieldValue(value)
+      }
+    }
+  }
+
+  for i := 0; i < t.NumField(); i++ {
+    name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+
+    if value, ok := fields[name]; ok && name != "" && name != "-" {
+      fields[name] = coerceValue(value, t.Field(i).Type)
+    }
+  }
+
+  return json.Marshal(fields)
+}
+
+// customFieldValue unwraps the {"type": ..., "value": ...} objects version
+// 2 payloads send as custom field values. Values without a "value"
+// key, such as options of enum and set fields, are kept as sent.
+func customFieldValue(data json.RawMessage) json.RawMessage {
+  var field struct {
+    Value json.RawMessage `json:"value"`
+  }
+
+  if err := json.Unmarshal(data, &field); err != nil || len(field.Value) == 0 {
+    return data
+  }
+
+  return field.Value
+}
+
+// coerceValue converts a JSON value to the kind of t, leaving the values it
+// does not know how to convert as they are:
+//
+//   struct      IDs of related items become {"id": n, "value": n}, as the
+//               API returns them, e.g. person_id
+//   string      numbers become strings, e.g. visible_to
+//   int, uint   numeric strings and fractional numbers become integers,
+//               empty strings become null
+//   float       numeric strings become numbers
+//   bool        numbers and numeric or boolean strings become booleans,
+//               e.g. active
+//
+// Types implementing json.Unmarshaler decode their values themselves.
+func coerceValue(data json.RawMessage, t reflect.Type) json.RawMessage {
+  raw := bytes.TrimSpace(data)
+
+  if len(raw) == 0 || string(raw) == "null" || reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+    return data
+  }
+
+  number := raw[0] == '-' || (raw[0] >= '0' && raw[0] <= '9')
+
+  var s string
+
+  if raw[0] == '"' {
+    if err := json.Unmarshal(raw, &s); err != nil {
+      return data
+    }
+  }
+
+  switch t.Kind() {
+  case reflect.Struct:
+    if number {
+      return json.RawMessage(fmt.Sprintf(`{"id":%s,"value":%s}`, raw, raw))
+    }
+  case reflect.String:
+    if number {
+      value, _ := json.Marshal(string(raw))
+      return value
+    }
+  case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+    reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+    if raw[0] == '"' && s == "" {
+      return json.RawMessage("null")
+    }
+
+    if raw[0] == '"' {
+      raw = []byte(s)
+    }
+
+    if f, err := strconv.ParseFloat(string(raw), 64); err == nil {
+      return json.RawMessage(strconv.FormatInt(int64(f), 10))
+    }
+  case reflect.Float32, reflect.Float64:
+    if _, err := strconv.ParseFloat(s, 64); err == nil {
+      return json.RawMessage(s)
+    }
+  case reflect.Bool:
+    if number || raw[0] == '"' {
+      if number {
+        s = string(raw)
+      }
+
+      if value, err := strconv.ParseFloat(s, 64); err == nil {
+        return json.RawMessage(strconv.FormatBool(value != 0))
+      }
+
+      if value, err := strconv.ParseBool(s); err == nil {
+        return json.RawMessage(strconv.FormatBool(value))
+      }
+    }
+  }
+
+  return data
+}
@@ -0,0 +1,134 @@
+package webhook
+
+import (
+  "encoding/json"
+  "errors"
+  "reflect"
+  "testing"
+  "time"
+
+  "github.com/dinistavares/pipedrive-api/pipedrive"
+)
+
+const dealV1 = `{
+  "v": 1,
+  "retry": 1,
+  "current": {"id": 7, "title": "Big deal", "value": 1500.5, "user_id": 3, "person_id": 12, "org_id": null, "visible_to": 3, "active": 1, "abc123": "custom"},
+  "previous": {"id": 7, "title": "Small deal", "value": 100, "user_id": 3, "person_id": 12},
+  "meta": {"v": 1, "action": "updated", "object": "deal", "id": 7, "company_id": 1, "user_id": 3, "webhook_id": "42", "timestamp": 1700000000, "timestamp_micro": 1700000000123456, "change_source": "app"}
+}`
+
+const dealV2 = `{
+  "data": {"id": 7, "title": "Big deal", "value": 1500, "owner_id": 3, "person_id": 12, "custom_fields": {"abc123": {"type": "varchar", "value": "custom"}, "def456": null}},
+  "previous": {"title": "Small deal"},
+  "meta": {"id": "0f1e", "version": "2.0", "action": "change", "entity": "deal", "entity_id": "7", "company_id": "1", "user_id": "3", "webhook_id": "42", "attempt": 2, "timestamp": "2023-11-14T22:13:20.123Z", "is_bulk_edit": false}
+}`
+
+func TestParseEvent_v1(t *testing.T) {
+  event, err := ParseEvent([]byte(dealV1))
+
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if event.Version != pipedrive.WebhookVersion1 || event.Action != pipedrive.ACTION_UPDATED || event.Object != pipedrive.OBJECT_DEAL {
+    t.Errorf("event = %v %v %v", event.Version, event.Action, event.Object)
+  }
+
+  if event.ItemID != 7 || event.CompanyID != 1 || event.UserID != 3 || event.WebhookID != "42" || event.Attempt != 2 {
+    t.Errorf("event = %+v", event)
+  }
+
+  if want := time.UnixMicro(1700000000123456).UTC(); !event.Timestamp.Equal(want) {
+    t.Errorf("Timestamp = %v, want %v", event.Timestamp, want)
+  }
+
+  if event.ID == "" {
+    t.Error("ID is empty")
+  }
+
+  deal, ok := event.Current.(pipedrive.Deal)
+
+  if !ok {
+    t.Fatalf("Current = %T, want Deal", event.Current)
+  }
+
+  if deal.Title != "Big deal" || deal.Value != 1500 || deal.PersonID.Value != 12 || deal.UserID.ID != 3 || deal.VisibleTo != "3" || !deal.Active {
+    t.Errorf("Current = %+v", deal)
+  }
+
+  if deal.CustomFields["abc123"] != "custom" {
+    t.Errorf("CustomFields = %v", deal.CustomFields)
+  }
+
+  if previous := event.Previous.(pipedrive.Deal); previous.Title != "Small deal" {
+    t.Errorf("Previous = %+v", previous)
+  }
+}
+
+func TestParseEvent_v2(t *testing.T) {
+  event, err := ParseEvent([]byte(dealV2))
+
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if event.Version != pipedrive.WebhookVersion2 || event.Action != pipedrive.ACTION_UPDATED || event.Object != pipedrive.OBJECT_DEAL {
+    t.Errorf("event = %v %v %v", event.Version, event.Action, event.Object)
+  }
+
+  if event.ID != "0f1e" || event.ItemID != 7 || event.UserID != 3 || event.Attempt != 2 {
+    t.Errorf("event = %+v", event)
+  }
+
+  deal := event.Current.(pipedrive.Deal)
+
+  if deal.Title != "Big deal" || deal.PersonID.Value != 12 || deal.CustomFields["abc123"] != "custom" {
+    t.Errorf("Current = %+v", deal)
+  }
+
+  if _, ok := deal.CustomFields["custom_fields"]; ok {
+    t.Errorf("custom_fields not flattened: %v", deal.CustomFields)
+  }
+}
+
+func TestParseEvent_invalid(t *testing.T) {
+  for _, body := range []string{`not json`, `{}`, `{"meta": {"v": 3}}`} {
+    if _, err := ParseEvent([]byte(body)); !errors.Is(err, ErrPayload) {
+      t.Errorf("ParseEvent(%s) returned %v, want ErrPayload", body, err)
+    }
+  }
+}
+
+func TestCoerceValue(t *testing.T) {
+  tests := []struct {
+    value string
+    t     interface{}
+    want  string
+  }{
+    {`12`, pipedrive.PersonID{}, `{"id":12,"value":12}`},
+    {`{"id":12}`, pipedrive.PersonID{}, `{"id":12}`},
+    {`3`, "", `"3"`},
+    {`true`, "", `true`},
+    {`"5"`, 0, `5`},
+    {`1500.5`, 0, `1500`},
+    {`""`, uint(0), `null`},
+    {`"x"`, 0, `"x"`},
+    {`"1.5"`, 0.0, `1.5`},
+    {`"x"`, 0.0, `"x"`},
+    {`1`, false, `true`},
+    {`0`, false, `false`},
+    {`"1"`, false, `true`},
+    {`"false"`, false, `false`},
+    {`null`, "", `null`},
+    {`"2024-01-01 10:00:00"`, pipedrive.Timestamp{}, `"2024-01-01 10:00:00"`},
+  }
+
+  for _, test := range tests {
+    got := coerceValue(json.RawMessage(test.value), reflect.TypeOf(test.t))
+
+    if string(got) != test.want {
+      t.Errorf("coerceValue(%s, %T) = %s, want %s", test.value, test.t, got, test.want)
+    }
+  }
+}
@@ -0,0 +1,542 @@
+// Package webhook receives the events of Pipedrive webhooks, see
+// pipedrive.WebhooksService.
+package webhook
+
+import (
+  "context"
+  "crypto/subtle"
+  "encoding/json"
+  "errors"
+  "fmt"
+  "hash/fnv"
+  "io/ioutil"
+  "net/http"
+  "sync"
+  "time"
+
+  "github.com/dinistavares/pipedrive-api/pipedrive"
+)
+
+// Maximum size of the request bodies read by Handler.
+const maxBodySize = 10 << 20
+
+var (
+  // ErrInProgress is reported for events delivered again while their
+  // first delivery is still being processed.
+  ErrInProgress = errors.New("pipedrive: webhook event in progress")
+
+  // ErrQueueFull is reported for events received while the queue of a
+  // started Handler is full.
+  ErrQueueFull = errors.New("pipedrive: webhook queue full")
+
+  // ErrHandlerStopped is reported for events received after
+  // Handler.Shutdown was called.
+  ErrHandlerStopped = errors.New("pipedrive: webhook handler stopped")
+)
+
+// EventHandler handles an event received by Handler.
+type EventHandler func(ctx context.Context, event *Event) error
+
+type route struct {
+  object  pipedrive.EventObject
+  action  pipedrive.EventAction
+  handler EventHandler
+}
+
+type job struct {
+  r     *http.Request
+  event *Event
+}
+
+// statusError carries the status code a failure is answered with.
+type statusError struct {
+  code int
+  err  error
+}
+
+func (e *statusError) Error() string {
+  return e.err.Error()
+}
+
+func (e *statusError) Unwrap() error {
+  return e.err
+}
+
+// Handler is an http.Handler receiving the requests of webhooks created
+// with pipedrive.WebhooksService.Create. It checks the HTTP basic auth
+// credentials the webhook was created with, decodes the events and passes
+// them to the handlers registered for their object and action.
+//
+//   handler := webhook.NewHandler("user", "password")
+//
+//   handler.Handle(pipedrive.OBJECT_DEAL, pipedrive.ACTION_UPDATED, func(ctx context.Context, event *webhook.Event) error {
+//     deal := event.Current.(pipedrive.Deal)
+//     ...
+//   })
+//
+//   http.Handle("/pipedrive", handler)
+//
+// Pipedrive retries deliveries and may reorder them. Events already
+// processed, and events older than the last processed change of their
+// item, are acknowledged without calling the handlers. Changes are ordered
+// by the update_time of the items, or the time of the event for deletions.
+//
+// Events are answered with 200 OK once handled. Failures are answered with
+// status codes that make Pipedrive send the event again: 500 Internal
+// Server Error when a handler fails, 409 Conflict while the event is
+// already being processed and 503 Service Unavailable when the store or
+// the queue is unavailable.
+type Handler struct {
+  // OnError, if set, is called with the reason of every request that is
+  // not answered with 200 OK, and of every queued event that fails.
+  OnError func(r *http.Request, err error)
+
+  // Store remembers the processed events. NewHandler sets it to a
+  // MemoryStore. Events are neither deduplicated nor ordered when it is
+  // nil.
+  Store EventStore
+
+  user     string
+  password string
+
+  mu     sync.RWMutex
+  routes []route
+
+  // Events being processed, and locks serializing the events of an item.
+  inProgressMu sync.Mutex
+  inProgress   map[string]bool
+  items        keyedMutex
+
+  // Queues of the workers run by Start.
+  queues  []chan job
+  stopped bool
+  workers sync.WaitGroup
+}
+
+// NewHandler returns a Handler accepting requests sent with the
+// HTTPAuthUser and HTTPAuthPassword of pipedrive.WebhooksCreateOptions.
+// Requests are not authenticated when both are empty.
+func NewHandler(user, password string) *Handler {
+  return &Handler{
+    Store:    NewMemoryStore(0),
+    user:     user,
+    password: password,
+  }
+}
+
+// Handle registers handler for the events about object with action.
+// pipedrive.OBJECT_ALL_ and pipedrive.ACTION_ALL match any object and any
+// action. Handlers matching an event are called in the order they were
+// registered.
+func (h *Handler) Handle(object pipedrive.EventObject, action pipedrive.EventAction, handler EventHandler) {
+  h.mu.Lock()
+  defer h.mu.Unlock()
+
+  h.routes = append(h.routes, route{
+    object:  object,
+    action:  action,
+    handler: handler,
+  })
+}
+
+// Dispatch passes event to the handlers registered for its object and
+// action, stopping at the first error. Events no handler matches are
+// ignored. Dispatch neither deduplicates nor orders events.
+func (h *Handler) Dispatch(ctx context.Context, event *Event) error {
+  h.mu.RLock()
+  routes := h.routes
+  h.mu.RUnlock()
+
+  for _, route := range routes {
+    if route.object != pipedrive.OBJECT_ALL_ && route.object != event.Object {
+      continue
+    }
+
+    if route.action != pipedrive.ACTION_ALL && route.action != event.Action {
+      continue
+    }
+
+    if err := route.handler(ctx, event); err != nil {
+      return err
+    }
+  }
+
+  return nil
+}
+
+// Start processes events in the background with the given number of
+// workers, answering requests as soon as their event is queued. Events of
+// the same item are processed in order by the same worker. Up to
+// queueSize events wait per worker, further requests are answered with
+// 503 Service Unavailable so that Pipedrive sends them again later.
+//
+// Queued events have been acknowledged, so the failures of their
+// handlers are only reported to OnError. When ctx is done, the handler
+// stops accepting events as if Shutdown was called, and the workers
+// process the queued events before returning. Use Shutdown to wait for
+// them.
+func (h *Handler) Start(ctx context.Context, workers, queueSize int) {
+  if workers <= 0 {
+    workers = 1
+  }
+
+  h.mu.Lock()
+  defer h.mu.Unlock()
+
+  if h.queues != nil {
+    return
+  }
+
+  h.queues = make([]chan job, workers)
+
+  for i := range h.queues {
+    queue := make(chan job, queueSize)
+    h.queues[i] = queue
+
+    h.workers.Add(1)
+
+    go func() {
+      defer h.workers.Done()
+
+      for {
+        select {
+        case job, ok := <-queue:
+          if !ok {
+            return
+          }
+
+          h.run(ctx, job)
+        case <-ctx.Done():
+          h.stop()
+
+          // The queue is closed by stop. Its events were acknowledged, so
+          // they are processed without the cancelled ctx.
+          for job := range queue {
+            h.run(detachedContext{ctx}, job)
+          }
+
+          return
+        }
+      }
+    }()
+  }
+}
+
+// Shutdown stops accepting events and waits until the queued ones are
+// processed or ctx is done.
+func (h *Handler) Shutdown(ctx context.Context) error {
+  h.stop()
+
+  done := make(chan struct{})
+
+  go func() {
+    h.workers.Wait()
+    close(done)
+  }()
+
+  select {
+  case <-done:
+    return nil
+  case <-ctx.Done():
+    return ctx.Err()
+  }
+}
+
+// stop makes enqueue refuse events and closes the queues of the workers.
+func (h *Handler) stop() {
+  h.mu.Lock()
+  defer h.mu.Unlock()
+
+  if !h.stopped {
+    h.stopped = true
+
+    for _, queue := range h.queues {
+      close(queue)
+    }
+  }
+}
+
+// run processes a queued event, reporting its failure to OnError.
+func (h *Handler) run(ctx context.Context, job job) {
+  if err := h.process(ctx, job.event); err != nil && h.OnError != nil {
+    h.OnError(job.r, err)
+  }
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+  if r.Method != http.MethodPost {
+    w.Header().Set("Allow", http.MethodPost)
+    h.fail(w, r, http.StatusMethodNotAllowed, nil)
+    return
+  }
+
+  if !h.authorized(r) {
+    w.Header().Set("WWW-Authenticate", `Basic realm="pipedrive"`)
+    h.fail(w, r, http.StatusUnauthorized, pipedrive.ErrUnauthorized)
+    return
+  }
+
+  body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+
+  if err != nil {
+    h.fail(w, r, http.StatusBadRequest, err)
+    return
+  }
+
+  event, err := ParseEvent(body)
+
+  if err != nil {
+    h.fail(w, r, http.StatusBadRequest, err)
+    return
+  }
+
+  if err := h.enqueue(r, event); err != nil {
+    code := http.StatusInternalServerError
+
+    var statusErr *statusError
+
+    if errors.As(err, &statusErr) {
+      code = statusErr.code
+    }
+
+    h.fail(w, r, code, err)
+    return
+  }
+
+  w.WriteHeader(http.StatusOK)
+}
+
+// enqueue queues event for the workers run by Start, or processes it
+// right away when none run.
+func (h *Handler) enqueue(r *http.Request, event *Event) error {
+  h.mu.RLock()
+
+  if h.stopped {
+    h.mu.RUnlock()
+    return &statusError{http.StatusServiceUnavailable, ErrHandlerStopped}
+  }
+
+  if h.queues == nil {
+    h.mu.RUnlock()
+    return h.process(r.Context(), event)
+  }
+
+  defer h.mu.RUnlock()
+
+  key := eventItemKey(event)
+
+  if key == "" {
+    key = event.ID
+  }
+
+  hash := fnv.New32a()
+  hash.Write([]byte(key))
+
+  select {
+  case h.queues[hash.Sum32()%uint32(len(h.queues))] <- job{r, event}:
+    return nil
+  default:
+    return &statusError{http.StatusServiceUnavailable, ErrQueueFull}
+  }
+}
+
+// process dispatches event unless it was processed already or is older
+// than the last processed change of its item, and records it once
+// handled.
+func (h *Handler) process(ctx context.Context, event *Event) error {
+  if h.Store == nil {
+    return h.Dispatch(ctx, event)
+  }
+
+  eventKey := "event:" + event.ID
+  itemKey := eventItemKey(event)
+
+  if !h.begin(eventKey) {
+    return &statusError{http.StatusConflict, ErrInProgress}
+  }
+
+  defer h.end(eventKey)
+
+  if itemKey != "" {
+    unlock := h.items.lock(itemKey)
+    defer unlock()
+  }
+
+  if _, seen, err := h.Store.Get(ctx, eventKey); err != nil || seen {
+    return storeError(err)
+  }
+
+  changed := changeTime(event)
+  stale := false
+
+  if itemKey != "" {
+    last, ok, err := h.Store.Get(ctx, itemKey)
+
+    if err != nil {
+      return storeError(err)
+    }
+
+    stale = ok && changed.Before(last)
+  }
+
+  if !stale {
+    if err := h.Dispatch(ctx, event); err != nil {
+      return err
+    }
+
+    if itemKey != "" {
+      if err := h.Store.Put(ctx, itemKey, changed); err != nil {
+        return storeError(err)
+      }
+    }
+  }
+
+  return storeError(h.Store.Put(ctx, eventKey, changed))
+}
+
+func (h *Handler) begin(key string) bool {
+  h.inProgressMu.Lock()
+  defer h.inProgressMu.Unlock()
+
+  if h.inProgress[key] {
+    return false
+  }
+
+  if h.inProgress == nil {
+    h.inProgress = make(map[string]bool)
+  }
+
+  h.inProgress[key] = true
+
+  return true
+}
+
+func (h *Handler) end(key string) {
+  h.inProgressMu.Lock()
+  defer h.inProgressMu.Unlock()
+
+  delete(h.inProgress, key)
+}
+
+// authorized compares both credentials in constant time.
+func (h *Handler) authorized(r *http.Request) bool {
+  if h.user == "" && h.password == "" {
+    return true
+  }
+
+  user, password, ok := r.BasicAuth()
+
+  userOK := subtle.ConstantTimeCompare([]byte(user), []byte(h.user)) == 1
+  passwordOK := subtle.ConstantTimeCompare([]byte(password), []byte(h.password)) == 1
+
+  return ok && userOK && passwordOK
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, code int, err error) {
+  if h.OnError != nil && err != nil {
+    h.OnError(r, err)
+  }
+
+  if code == http.StatusServiceUnavailable {
+    w.Header().Set("Retry-After", "30")
+  }
+
+  http.Error(w, http.StatusText(code), code)
+}
+
+func storeError(err error) error {
+  if err == nil {
+    return nil
+  }
+
+  return &statusError{http.StatusServiceUnavailable, fmt.Errorf("webhook event store: %w", err)}
+}
+
+// eventItemKey names the item an event is about, or is empty when the
+// event has no item ID.
+func eventItemKey(event *Event) string {
+  if event.ItemID == 0 {
+    return ""
+  }
+
+  return fmt.Sprintf("item:%v:%v", event.Object, event.ItemID)
+}
+
+// changeTime returns the time of the change an event notifies: the
+// update_time of the item, or the time of the event for deletions and
+// items without one.
+//
+// Events are not ordered by meta.version, which is the version of the
+// payload format ("1.0" or "2.0") rather than a revision of the item, so
+// it can not tell which of two changes is the latest.
+func changeTime(event *Event) time.Time {
+  var item struct {
+    UpdateTime pipedrive.Timestamp `json:"update_time"`
+  }
+
+  if err := json.Unmarshal(event.RawCurrent, &item); err == nil && !item.UpdateTime.IsZero() {
+    return item.UpdateTime.Time
+  }
+
+  return event.Timestamp
+}
+
+// detachedContext keeps the values of a context but is never done.
+type detachedContext struct {
+  context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+  return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+  return nil
+}
+
+func (detachedContext) Err() error {
+  return nil
+}
+
+// keyedMutex holds a mutex per key, kept while it is in use.
+type keyedMutex struct {
+  mu    sync.Mutex
+  locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+  sync.Mutex
+  refs int
+}
+
+// lock locks the mutex of key and returns the function unlocking it.
+func (m *keyedMutex) lock(key string) func() {
+  m.mu.Lock()
+
+  if m.locks == nil {
+    m.locks = make(map[string]*keyedLock)
+  }
+
+  l, ok := m.locks[key]
+
+  if !ok {
+    l = &keyedLock{}
+    m.locks[key] = l
+  }
+
+  l.refs++
+  m.mu.Unlock()
+
+  l.Lock()
+
+  return func() {
+    l.Unlock()
+
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    if l.refs--; l.refs == 0 {
+      delete(m.locks, key)
+    }
+  }
+}
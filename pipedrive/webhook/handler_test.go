@@ -0,0 +1,238 @@
+package webhook
+
+import (
+  "context"
+  "errors"
+  "net/http"
+  "net/http/httptest"
+  "strings"
+  "testing"
+  "time"
+
+  "github.com/dinistavares/pipedrive-api/pipedrive"
+)
+
+func TestHandler(t *testing.T) {
+  handler := NewHandler("user", "secret")
+
+  var calls []string
+
+  handler.Handle(pipedrive.OBJECT_DEAL, pipedrive.ACTION_UPDATED, func(ctx context.Context, event *Event) error {
+    calls = append(calls, "deal.updated")
+    return nil
+  })
+
+  handler.Handle(pipedrive.OBJECT_ALL_, pipedrive.ACTION_ALL, func(ctx context.Context, event *Event) error {
+    calls = append(calls, "*.*")
+    return nil
+  })
+
+  handler.Handle(pipedrive.OBJECT_PERSON, pipedrive.ACTION_ALL, func(ctx context.Context, event *Event) error {
+    calls = append(calls, "person.*")
+    return nil
+  })
+
+  send := func(user, password, body string) int {
+    req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+    req.SetBasicAuth(user, password)
+
+    rec := httptest.NewRecorder()
+    handler.ServeHTTP(rec, req)
+
+    return rec.Code
+  }
+
+  if code := send("user", "wrong", dealV1); code != http.StatusUnauthorized {
+    t.Errorf("wrong password answered %v", code)
+  }
+
+  if code := send("user", "secret", `{`); code != http.StatusBadRequest {
+    t.Errorf("invalid body answered %v", code)
+  }
+
+  if len(calls) != 0 {
+    t.Fatalf("rejected requests called %v", calls)
+  }
+
+  if code := send("user", "secret", dealV2); code != http.StatusOK {
+    t.Errorf("event answered %v", code)
+  }
+
+  if want := "deal.updated,*.*"; strings.Join(calls, ",") != want {
+    t.Errorf("calls = %v, want %v", calls, want)
+  }
+
+  handler.Handle(pipedrive.OBJECT_DEAL, pipedrive.ACTION_ALL, func(ctx context.Context, event *Event) error {
+    return errors.New("boom")
+  })
+
+  if code := send("user", "secret", dealV1); code != http.StatusInternalServerError {
+    t.Errorf("failing handler answered %v", code)
+  }
+}
+
+func dealEvent(id, updateTime string) string {
+  return `{
+    "data": {"id": 7, "title": "Deal", "update_time": "` + updateTime + `"},
+    "meta": {"id": "` + id + `", "version": "2.0", "action": "change", "entity": "deal", "entity_id": "7", "timestamp": "` + updateTime + `"}
+  }`
+}
+
+func TestHandler_deduplicatesAndOrders(t *testing.T) {
+  handler := NewHandler("", "")
+
+  var handled []string
+  fail := true
+
+  handler.Handle(pipedrive.OBJECT_DEAL, pipedrive.ACTION_ALL, func(ctx context.Context, event *Event) error {
+    if fail {
+      fail = false
+      return errors.New("boom")
+    }
+
+    handled = append(handled, event.ID)
+    return nil
+  })
+
+  send := func(body string) int {
+    rec := httptest.NewRecorder()
+    handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+    return rec.Code
+  }
+
+  newer := dealEvent("b", "2024-01-01T10:00:05Z")
+  older := dealEvent("a", "2024-01-01T10:00:00Z")
+
+  if code := send(newer); code != http.StatusInternalServerError {
+    t.Errorf("failing event answered %v", code)
+  }
+
+  // Failed events are not recorded, so the retry is processed.
+  for i := 0; i < 2; i++ {
+    if code := send(newer); code != http.StatusOK {
+      t.Errorf("event answered %v", code)
+    }
+  }
+
+  if code := send(older); code != http.StatusOK {
+    t.Errorf("stale event answered %v", code)
+  }
+
+  if want := "b"; strings.Join(handled, ",") != want {
+    t.Errorf("handled %v, want %v", handled, want)
+  }
+}
+
+func TestHandler_queue(t *testing.T) {
+  handler := NewHandler("", "")
+
+  release := make(chan struct{})
+  handled := make(chan string, 10)
+
+  handler.Handle(pipedrive.OBJECT_ALL_, pipedrive.ACTION_ALL, func(ctx context.Context, event *Event) error {
+    <-release
+    handled <- event.ID
+    return nil
+  })
+
+  handler.Start(context.Background(), 1, 1)
+
+  send := func(body string) int {
+    rec := httptest.NewRecorder()
+    handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+    return rec.Code
+  }
+
+  if code := send(dealEvent("a", "2024-01-01T10:00:00Z")); code != http.StatusOK {
+    t.Errorf("first event answered %v", code)
+  }
+
+  // Wait for the worker to take the first event off the queue.
+  for i := 0; i < 100 && len(handler.queues[0]) > 0; i++ {
+    time.Sleep(time.Millisecond)
+  }
+
+  if code := send(dealEvent("b", "2024-01-01T10:00:01Z")); code != http.StatusOK {
+    t.Errorf("queued event answered %v", code)
+  }
+
+  if code := send(dealEvent("c", "2024-01-01T10:00:02Z")); code != http.StatusServiceUnavailable {
+    t.Errorf("event beyond the queue answered %v", code)
+  }
+
+  close(release)
+
+  if err := handler.Shutdown(context.Background()); err != nil {
+    t.Fatal(err)
+  }
+
+  close(handled)
+
+  var ids []string
+
+  for id := range handled {
+    ids = append(ids, id)
+  }
+
+  if want := "a,b"; strings.Join(ids, ",") != want {
+    t.Errorf("handled %v, want %v", ids, want)
+  }
+
+  if code := send(dealEvent("d", "2024-01-01T10:00:03Z")); code != http.StatusServiceUnavailable {
+    t.Errorf("event after shutdown answered %v", code)
+  }
+}
+
+func TestHandler_drainsQueueWhenCancelled(t *testing.T) {
+  handler := NewHandler("", "")
+
+  release := make(chan struct{})
+  handled := make(chan string, 10)
+
+  handler.Handle(pipedrive.OBJECT_ALL_, pipedrive.ACTION_ALL, func(ctx context.Context, event *Event) error {
+    <-release
+    handled <- event.ID
+    return nil
+  })
+
+  ctx, cancel := context.WithCancel(context.Background())
+  handler.Start(ctx, 1, 1)
+
+  send := func(body string) int {
+    rec := httptest.NewRecorder()
+    handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+    return rec.Code
+  }
+
+  send(dealEvent("a", "2024-01-01T10:00:00Z"))
+
+  for i := 0; i < 100 && len(handler.queues[0]) > 0; i++ {
+    time.Sleep(time.Millisecond)
+  }
+
+  if code := send(dealEvent("b", "2024-01-01T10:00:01Z")); code != http.StatusOK {
+    t.Fatalf("queued event answered %v", code)
+  }
+
+  cancel()
+  close(release)
+  handler.workers.Wait()
+  close(handled)
+
+  var ids []string
+
+  for id := range handled {
+    ids = append(ids, id)
+  }
+
+  if want := "a,b"; strings.Join(ids, ",") != want {
+    t.Errorf("handled %v, want %v", ids, want)
+  }
+
+  if code := send(dealEvent("c", "2024-01-01T10:00:02Z")); code != http.StatusServiceUnavailable {
+    t.Errorf("event after cancellation answered %v", code)
+  }
+}
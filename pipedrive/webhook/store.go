@@ -0,0 +1,279 @@
+package webhook
+
+import (
+  "bytes"
+  "container/list"
+  "context"
+  "encoding/json"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "sync"
+  "time"
+)
+
+// Number of keys kept by event stores created with a size of 0.
+const DefaultStoreSize = 10000
+
+// EventStore remembers the events processed by Handler, so that
+// redelivered and outdated events are skipped. It maps keys naming events
+// and items to the time of their last change. Stores may forget old keys
+// to bound their size.
+type EventStore interface {
+  // Get returns the time recorded for key, and false when there is none.
+  Get(ctx context.Context, key string) (time.Time, bool, error)
+
+  // Put records t for key.
+  Put(ctx context.Context, key string, t time.Time) error
+}
+
+type storeEntry struct {
+  Key  string    `json:"key"`
+  Time time.Time `json:"time"`
+}
+
+// lruCache holds up to size entries, evicting the least recently used.
+type lruCache struct {
+  size    int
+  entries *list.List
+  keys    map[string]*list.Element
+}
+
+func newLRUCache(size int) *lruCache {
+  if size <= 0 {
+    size = DefaultStoreSize
+  }
+
+  return &lruCache{
+    size:    size,
+    entries: list.New(),
+    keys:    make(map[string]*list.Element),
+  }
+}
+
+func (l *lruCache) get(key string) (time.Time, bool) {
+  e, ok := l.keys[key]
+
+  if !ok {
+    return time.Time{}, false
+  }
+
+  l.entries.MoveToFront(e)
+
+  return e.Value.(*storeEntry).Time, true
+}
+
+func (l *lruCache) put(key string, t time.Time) {
+  if e, ok := l.keys[key]; ok {
+    e.Value.(*storeEntry).Time = t
+    l.entries.MoveToFront(e)
+    return
+  }
+
+  l.keys[key] = l.entries.PushFront(&storeEntry{Key: key, Time: t})
+
+  for l.entries.Len() > l.size {
+    oldest := l.entries.Back()
+    l.entries.Remove(oldest)
+    delete(l.keys, oldest.Value.(*storeEntry).Key)
+  }
+}
+
+// list returns the entries from the least to the most recently used.
+func (l *lruCache) list() []storeEntry {
+  entries := make([]storeEntry, 0, l.entries.Len())
+
+  for e := l.entries.Back(); e != nil; e = e.Prev() {
+    entries = append(entries, *e.Value.(*storeEntry))
+  }
+
+  return entries
+}
+
+// MemoryStore keeps the most recently used keys in memory.
+type MemoryStore struct {
+  mu  sync.Mutex
+  lru *lruCache
+}
+
+// NewMemoryStore returns a store keeping up to size keys, or
+// DefaultStoreSize keys if size is 0.
+func NewMemoryStore(size int) *MemoryStore {
+  return &MemoryStore{lru: newLRUCache(size)}
+}
+
+// Get returns the time recorded for key.
+func (s *MemoryStore) Get(ctx context.Context, key string) (time.Time, bool, error) {
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  t, ok := s.lru.get(key)
+
+  return t, ok, nil
+}
+
+// Put records t for key.
+func (s *MemoryStore) Put(ctx context.Context, key string, t time.Time) error {
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  s.lru.put(key, t)
+
+  return nil
+}
+
+// FileStore keeps the most recently used keys in memory and in a file, so
+// that they survive restarts. The file is read on first use. Put appends
+// a line of JSON to it, and the file is rewritten with the kept keys once
+// it holds twice as many lines, so that writes stay cheap and the file
+// stays bounded.
+type FileStore struct {
+  Path string
+  Size int
+
+  mu    sync.Mutex
+  lru   *lruCache
+  lines int
+}
+
+// NewFileStore returns a store backed by the file at path, keeping up to
+// size keys, or DefaultStoreSize keys if size is 0.
+func NewFileStore(path string, size int) *FileStore {
+  return &FileStore{Path: path, Size: size}
+}
+
+// Get returns the time recorded for key.
+func (s *FileStore) Get(ctx context.Context, key string) (time.Time, bool, error) {
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  if err := s.load(); err != nil {
+    return time.Time{}, false, err
+  }
+
+  t, ok := s.lru.get(key)
+
+  return t, ok, nil
+}
+
+// Put records t for key and appends it to the file.
+func (s *FileStore) Put(ctx context.Context, key string, t time.Time) error {
+  s.mu.Lock()
+  defer s.mu.Unlock()
+
+  if err := s.load(); err != nil {
+    return err
+  }
+
+  s.lru.put(key, t)
+
+  if s.lines >= 2*s.lru.size {
+    return s.compact()
+  }
+
+  data, err := json.Marshal(storeEntry{Key: key, Time: t})
+
+  if err != nil {
+    return err
+  }
+
+  f, err := os.OpenFile(s.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+
+  if err != nil {
+    return err
+  }
+
+  if _, err := f.Write(append(data, '\n')); err != nil {
+    f.Close()
+    return err
+  }
+
+  s.lines++
+
+  return f.Close()
+}
+
+// load reads the file, replaying its lines in order. Files holding a line
+// cut short by a crash are rewritten.
+func (s *FileStore) load() error {
+  if s.lru != nil {
+    return nil
+  }
+
+  lru := newLRUCache(s.Size)
+  data, err := ioutil.ReadFile(s.Path)
+
+  if err != nil && !os.IsNotExist(err) {
+    return err
+  }
+
+  lines := bytes.Split(data, []byte("\n"))
+  damaged := len(data) > 0 && data[len(data)-1] != '\n'
+
+  for _, line := range lines {
+    if len(line) == 0 {
+      continue
+    }
+
+    var entry storeEntry
+
+    if err := json.Unmarshal(line, &entry); err != nil {
+      damaged = true
+      continue
+    }
+
+    lru.put(entry.Key, entry.Time)
+    s.lines++
+  }
+
+  s.lru = lru
+
+  if damaged {
+    return s.compact()
+  }
+
+  return nil
+}
+
+// compact replaces the file with the kept keys.
+func (s *FileStore) compact() error {
+  var buf bytes.Buffer
+
+  entries := s.lru.list()
+
+  for _, entry := range entries {
+    data, err := json.Marshal(entry)
+
+    if err != nil {
+      return err
+    }
+
+    buf.Write(data)
+    buf.WriteByte('\n')
+  }
+
+  tmp, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+
+  if err != nil {
+    return err
+  }
+
+  defer os.Remove(tmp.Name())
+
+  if _, err := tmp.Write(buf.Bytes()); err != nil {
+    tmp.Close()
+    return err
+  }
+
+  if err := tmp.Close(); err != nil {
+    return err
+  }
+
+  if err := os.Rename(tmp.Name(), s.Path); err != nil {
+    return err
+  }
+
+  s.lines = len(entries)
+
+  return nil
+}
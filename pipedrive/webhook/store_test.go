@@ -0,0 +1,89 @@
+package webhook
+
+import (
+  "bytes"
+  "context"
+  "fmt"
+  "io/ioutil"
+  "path/filepath"
+  "testing"
+  "time"
+)
+
+func TestMemoryStore_evicts(t *testing.T) {
+  ctx := context.Background()
+  store := NewMemoryStore(2)
+  now := time.Now()
+
+  store.Put(ctx, "a", now)
+  store.Put(ctx, "b", now)
+  store.Get(ctx, "a")
+  store.Put(ctx, "c", now)
+
+  for key, want := range map[string]bool{"a": true, "b": false, "c": true} {
+    if _, ok, _ := store.Get(ctx, key); ok != want {
+      t.Errorf("Get(%v) found %v, want %v", key, ok, want)
+    }
+  }
+}
+
+func TestFileStore(t *testing.T) {
+  ctx := context.Background()
+  path := filepath.Join(t.TempDir(), "events.jsonl")
+  now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+  store := NewFileStore(path, 3)
+
+  for i := 0; i < 4; i++ {
+    if err := store.Put(ctx, fmt.Sprint(i), now.Add(time.Duration(i)*time.Second)); err != nil {
+      t.Fatal(err)
+    }
+  }
+
+  reopened := NewFileStore(path, 3)
+
+  if _, ok, err := reopened.Get(ctx, "0"); err != nil || ok {
+    t.Errorf("Get(0) = %v, %v, want evicted", ok, err)
+  }
+
+  got, ok, err := reopened.Get(ctx, "3")
+
+  if err != nil || !ok || !got.Equal(now.Add(3*time.Second)) {
+    t.Errorf("Get(3) = %v, %v, %v", got, ok, err)
+  }
+}
+
+func TestFileStore_compacts(t *testing.T) {
+  ctx := context.Background()
+  path := filepath.Join(t.TempDir(), "events.jsonl")
+  now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+  store := NewFileStore(path, 2)
+
+  for i := 0; i < 10; i++ {
+    store.Put(ctx, fmt.Sprint(i), now)
+  }
+
+  data, _ := ioutil.ReadFile(path)
+
+  if lines := bytes.Count(data, []byte("\n")); lines > 4 {
+    t.Errorf("file holds %d lines, want at most 4", lines)
+  }
+
+  // A line cut short by a crash is dropped.
+  ioutil.WriteFile(path, append(data, `{"key":"10","ti`...), 0600)
+
+  reopened := NewFileStore(path, 2)
+
+  for key, want := range map[string]bool{"7": false, "8": true, "9": true, "10": false} {
+    if _, ok, err := reopened.Get(ctx, key); err != nil || ok != want {
+      t.Errorf("Get(%v) = %v, %v, want %v", key, ok, err, want)
+    }
+  }
+
+  reopened.Put(ctx, "11", now)
+
+  if _, ok, _ := NewFileStore(path, 2).Get(ctx, "11"); !ok {
+    t.Error("key put after a damaged line was lost")
+  }
+}
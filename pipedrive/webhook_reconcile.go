@@ -0,0 +1,262 @@
+package pipedrive
+
+import (
+  "context"
+  "fmt"
+  "strings"
+)
+
+// WebhookSpec describes a desired webhook subscription. Subscriptions are
+// identified by their URL, event action and event object. UserID and
+// Version are only compared when set.
+type WebhookSpec WebhooksCreateOptions
+
+func (s WebhookSpec) key() string {
+  return fmt.Sprintf("%v %v.%v", s.SubscriptionURL, s.EventAction, s.EventObject)
+}
+
+// WebhookChangeType is the kind of change planned by WebhooksService.Plan.
+type WebhookChangeType string
+
+const (
+  WebhookChangeCreate   WebhookChangeType = "create"
+  WebhookChangeDelete   WebhookChangeType = "delete"
+  WebhookChangeRecreate WebhookChangeType = "recreate"
+)
+
+// WebhookChange is a change planned by WebhooksService.Plan.
+type WebhookChange struct {
+  Type WebhookChangeType
+
+  // Desired subscription, nil for deletions.
+  Spec *WebhookSpec
+
+  // Existing subscription, nil for creations.
+  Webhook *Webhook
+
+  // Settings differing between Webhook and Spec, for recreations.
+  Differences []string
+
+  // Subscription created by WebhooksService.Apply.
+  Created *Webhook
+}
+
+func (c WebhookChange) String() string {
+  switch c.Type {
+  case WebhookChangeCreate:
+    return fmt.Sprintf("create %v", c.Spec.key())
+  case WebhookChangeDelete:
+    return fmt.Sprintf("delete %v %v.%v (id %v)", c.Webhook.SubscriptionURL, c.Webhook.EventAction, c.Webhook.EventObject, c.Webhook.ID)
+  }
+
+  return fmt.Sprintf("recreate %v (id %v, %v changed)", c.Spec.key(), c.Webhook.ID, strings.Join(c.Differences, ", "))
+}
+
+// WebhookPlan lists the changes turning the existing webhook subscriptions
+// into the desired ones.
+type WebhookPlan struct {
+  Changes []WebhookChange
+
+  // Existing subscriptions matching the desired ones.
+  Unchanged []Webhook
+
+  // Subscriptions kept by the plan whose last delivery failed.
+  Failing []Webhook
+}
+
+// Empty reports whether the plan has no changes.
+func (p *WebhookPlan) Empty() bool {
+  return len(p.Changes) == 0
+}
+
+// Plan compares the desired webhook subscriptions with the existing ones
+// without changing them. Missing subscriptions are created and
+// subscriptions not desired are deleted. Subscriptions whose settings
+// changed, or which Pipedrive deactivated after failed deliveries, are
+// recreated, as webhooks cannot be updated.
+//
+// HTTP auth passwords are only compared when Pipedrive returns them
+// unmasked.
+func (s *WebhooksService) Plan(ctx context.Context, desired []WebhookSpec) (*WebhookPlan, error) {
+  record, _, err := s.List(ctx)
+
+  if err != nil {
+    return nil, err
+  }
+
+  var existing []Webhook
+
+  if record != nil {
+    existing = record.Data
+  }
+
+  return planWebhooks(desired, existing)
+}
+
+// Apply makes the changes of a plan, recreating subscriptions by creating
+// the new one before deleting the old one so that no event is missed. It
+// stops at the first failure and records the created subscriptions in
+// the plan.
+func (s *WebhooksService) Apply(ctx context.Context, plan *WebhookPlan) error {
+  for i := range plan.Changes {
+    change := &plan.Changes[i]
+
+    if change.Type != WebhookChangeDelete {
+      record, _, err := s.Create(ctx, (*WebhooksCreateOptions)(change.Spec))
+
+      if err != nil {
+        return fmt.Errorf("%v: %w", change, err)
+      }
+
+      if record != nil {
+        change.Created = &record.Data
+      }
+    }
+
+    if change.Type != WebhookChangeCreate {
+      if _, err := s.Delete(ctx, change.Webhook.ID); err != nil {
+        return fmt.Errorf("%v: %w", change, err)
+      }
+    }
+  }
+
+  return nil
+}
+
+// Reconcile plans and applies the changes turning the existing webhook
+// subscriptions into the desired ones, see Plan and Apply. Use Plan alone
+// for a dry run.
+func (s *WebhooksService) Reconcile(ctx context.Context, desired []WebhookSpec) (*WebhookPlan, error) {
+  plan, err := s.Plan(ctx, desired)
+
+  if err != nil {
+    return nil, err
+  }
+
+  return plan, s.Apply(ctx, plan)
+}
+
+func planWebhooks(desired []WebhookSpec, existing []Webhook) (*WebhookPlan, error) {
+  plan := &WebhookPlan{}
+  byKey := make(map[string][]Webhook)
+
+  for _, webhook := range existing {
+    key := webhook.spec().key()
+    byKey[key] = append(byKey[key], webhook)
+  }
+
+  seen := make(map[string]bool)
+
+  for i := range desired {
+    spec := &desired[i]
+    key := spec.key()
+
+    if seen[key] {
+      return nil, fmt.Errorf("webhook %v is desired more than once", key)
+    }
+
+    seen[key] = true
+    webhooks := byKey[key]
+    delete(byKey, key)
+
+    if len(webhooks) == 0 {
+      plan.Changes = append(plan.Changes, WebhookChange{Type: WebhookChangeCreate, Spec: spec})
+      continue
+    }
+
+    kept := -1
+
+    for j, webhook := range webhooks {
+      if len(spec.differences(webhook)) == 0 {
+        kept = j
+        break
+      }
+    }
+
+    if kept < 0 {
+      webhook := webhooks[0]
+
+      plan.Changes = append(plan.Changes, WebhookChange{
+        Type:        WebhookChangeRecreate,
+        Spec:        spec,
+        Webhook:     &webhook,
+        Differences: spec.differences(webhook),
+      })
+
+      webhooks = webhooks[1:]
+    } else {
+      plan.Unchanged = append(plan.Unchanged, webhooks[kept])
+
+      if webhooks[kept].failing() {
+        plan.Failing = append(plan.Failing, webhooks[kept])
+      }
+
+      webhooks = append(webhooks[:kept:kept], webhooks[kept+1:]...)
+    }
+
+    for j := range webhooks {
+      plan.Changes = append(plan.Changes, WebhookChange{Type: WebhookChangeDelete, Webhook: &webhooks[j]})
+    }
+  }
+
+  for _, webhook := range existing {
+    if _, ok := byKey[webhook.spec().key()]; ok {
+      webhook := webhook
+      plan.Changes = append(plan.Changes, WebhookChange{Type: WebhookChangeDelete, Webhook: &webhook})
+    }
+  }
+
+  return plan, nil
+}
+
+// differences lists the settings of webhook not matching the spec.
+func (s WebhookSpec) differences(webhook Webhook) []string {
+  var differences []string
+
+  if s.UserID != 0 && int(s.UserID) != webhook.UserID {
+    differences = append(differences, "user_id")
+  }
+
+  if s.Version != "" && s.Version != webhook.Version {
+    differences = append(differences, "version")
+  }
+
+  if s.HTTPAuthUser != webhookAuthValue(webhook.HTTPAuthUser) {
+    differences = append(differences, "http_auth_user")
+  }
+
+  password := webhookAuthValue(webhook.HTTPAuthPassword)
+
+  if (s.HTTPAuthPassword == "") != (password == "") || (strings.Trim(password, "*") != "" && s.HTTPAuthPassword != password) {
+    differences = append(differences, "http_auth_password")
+  }
+
+  if webhook.IsActive == 0 {
+    differences = append(differences, "is_active")
+  }
+
+  return differences
+}
+
+func (w Webhook) spec() WebhookSpec {
+  return WebhookSpec{
+    SubscriptionURL: w.SubscriptionURL,
+    EventAction:     EventAction(w.EventAction),
+    EventObject:     EventObject(w.EventObject),
+  }
+}
+
+// failing reports whether the last delivery of the webhook failed.
+func (w Webhook) failing() bool {
+  return w.LastHTTPStatus != 0 && (w.LastHTTPStatus < 200 || w.LastHTTPStatus > 299)
+}
+
+// webhookAuthValue returns the HTTP auth credentials of a webhook, which
+// are null when not set.
+func webhookAuthValue(v interface{}) string {
+  if s, ok := v.(string); ok {
+    return s
+  }
+
+  return ""
+}
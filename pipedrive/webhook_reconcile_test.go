@@ -0,0 +1,106 @@
+package pipedrive
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "strings"
+  "testing"
+)
+
+func TestWebhooksService_Reconcile(t *testing.T) {
+  client, mux, _ := setup(t)
+
+  var created []WebhooksCreateOptions
+  var deleted []string
+
+  mux.HandleFunc("/webhooks", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method == http.MethodPost {
+      var opt WebhooksCreateOptions
+      json.NewDecoder(r.Body).Decode(&opt)
+      created = append(created, opt)
+
+      fmt.Fprintf(w, `{"success": true, "data": {"id": %d, "subscription_url": %q}}`, 100+len(created), opt.SubscriptionURL)
+      return
+    }
+
+    fmt.Fprint(w, `{"success": true, "data": [
+      {"id": 1, "subscription_url": "https://example.com/hook", "event_action": "*", "event_object": "deal", "user_id": 5, "version": "2.0", "is_active": 1, "http_auth_user": "user", "http_auth_password": "******", "last_http_status": 502},
+      {"id": 2, "subscription_url": "https://example.com/hook", "event_action": "*", "event_object": "person", "user_id": 5, "version": "1.0", "is_active": 1, "http_auth_user": "user", "http_auth_password": "******"},
+      {"id": 3, "subscription_url": "https://old.example.com/hook", "event_action": "*", "event_object": "*", "user_id": 5, "version": "1.0", "is_active": 1}
+    ]}`)
+  })
+
+  mux.HandleFunc("/webhooks/", func(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodDelete {
+      t.Errorf("Request method: %v, want DELETE", r.Method)
+    }
+
+    deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/webhooks/"))
+    fmt.Fprint(w, `{"success": true}`)
+  })
+
+  spec := func(object EventObject) WebhookSpec {
+    return WebhookSpec{
+      SubscriptionURL:  "https://example.com/hook",
+      EventAction:      ACTION_ALL,
+      EventObject:      object,
+      HTTPAuthUser:     "user",
+      HTTPAuthPassword: "secret",
+      Version:          WebhookVersion2,
+    }
+  }
+
+  desired := []WebhookSpec{spec(OBJECT_DEAL), spec(OBJECT_PERSON), spec(OBJECT_ORGANIZATION)}
+
+  plan, err := client.Webhooks.Plan(context.Background(), desired)
+
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  var changes []string
+
+  for _, change := range plan.Changes {
+    changes = append(changes, change.String())
+  }
+
+  want := []string{
+    "recreate https://example.com/hook *.person (id 2, version changed)",
+    "create https://example.com/hook *.organization",
+    "delete https://old.example.com/hook *.* (id 3)",
+  }
+
+  if strings.Join(changes, "\n") != strings.Join(want, "\n") {
+    t.Errorf("Plan changes:\n%v\nwant:\n%v", strings.Join(changes, "\n"), strings.Join(want, "\n"))
+  }
+
+  if len(plan.Failing) != 1 || plan.Failing[0].ID != 1 {
+    t.Errorf("Plan failing = %v, want webhook 1", plan.Failing)
+  }
+
+  if len(created) != 0 || len(deleted) != 0 {
+    t.Fatalf("Plan changed webhooks: created %v, deleted %v", created, deleted)
+  }
+
+  if _, err := client.Webhooks.Reconcile(context.Background(), desired); err != nil {
+    t.Fatal(err)
+  }
+
+  if len(created) != 2 || created[0].EventObject != OBJECT_PERSON || created[1].EventObject != OBJECT_ORGANIZATION {
+    t.Errorf("created %v", created)
+  }
+
+  if strings.Join(deleted, ",") != "2,3" {
+    t.Errorf("deleted %v, want 2,3", deleted)
+  }
+}
+
+func TestWebhooksService_Plan_duplicateSpec(t *testing.T) {
+  spec := WebhookSpec{SubscriptionURL: "https://example.com/hook", EventAction: ACTION_ALL, EventObject: OBJECT_DEAL}
+
+  if _, err := planWebhooks([]WebhookSpec{spec, spec}, nil); err == nil {
+    t.Error("planWebhooks accepted a duplicate spec")
+  }
+}
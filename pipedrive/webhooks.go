@@ -32,6 +32,8 @@ type Webhook struct {
   LastDeliveryTime time.Time   `json:"last_delivery_time"`
   LastHTTPStatus   int         `json:"last_http_status"`
   AdminID          int         `json:"admin_id"`
+
+  Version WebhookVersion `json:"version"`
 }
 
 type WebhookVersion string 
@@ -45,6 +47,23 @@ func (w Webhook) String() string {
   return Stringify(w)
 }
 
+// WebhookObject is the item a webhook event is about, see the webhook
+// package. It holds one of Deal, Person, Organization, Activity, Note,
+// Product, Pipeline, Stage or User.
+type WebhookObject interface {
+  webhookObject()
+}
+
+func (Deal) webhookObject()         {}
+func (Person) webhookObject()       {}
+func (Organization) webhookObject() {}
+func (Activity) webhookObject()     {}
+func (Note) webhookObject()         {}
+func (Product) webhookObject()      {}
+func (Pipeline) webhookObject()     {}
+func (Stage) webhookObject()        {}
+func (User) webhookObject()         {}
+
 // WebhooksResponse represents multiple webhooks response.
 type WebhooksResponse struct {
   Status  string    `json:"status,omitempty"`
@@ -63,7 +82,7 @@ type WebhookResponse struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Webhooks/get_webhooks
 func (s *WebhooksService) List(ctx context.Context) (*WebhooksResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodGet, "/webhooks", nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, "/webhooks", nil, nil)
 
   if err != nil {
     return nil, nil, err
@@ -96,7 +115,7 @@ type WebhooksCreateOptions struct {
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Webhooks/post_webhooks
 func (s *WebhooksService) Create(ctx context.Context, opt *WebhooksCreateOptions) (*WebhookResponse, *Response, error) {
-  req, err := s.client.NewRequest(http.MethodPost, "/webhooks", nil, opt)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, "/webhooks", nil, opt)
 
   if err != nil {
     return nil, nil, err
@@ -118,7 +137,7 @@ func (s *WebhooksService) Create(ctx context.Context, opt *WebhooksCreateOptions
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Webhooks/delete_webhooks_id
 func (s *WebhooksService) Delete(ctx context.Context, id int) (*Response, error) {
   uri := fmt.Sprintf("/webhooks/%v", id)
-  req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+  req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, uri, nil, nil)
 
   if err != nil {
     return nil, err